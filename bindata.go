@@ -61,7 +61,367 @@ func (fi bindata_file_info) Sys() interface{} {
 	return nil
 }
 
-var _sushibox_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x56\x4d\x6f\xe3\x36\x10\x3d\x5b\xbf\x82\x15\x60\x80\xda\x75\x65\xef\x61\x51\xc0\x85\x0f\x49\x93\xa0\x29\x90\xc5\x22\xd9\xf6\x92\x35\x02\xda\xa2\x6c\x22\x12\x69\x90\xb4\x9b\x34\xf0\x7f\xef\x0c\x45\x4a\xb4\xea\x64\x9d\x5e\xe2\x68\x34\x1f\x6f\xde\x0c\x1f\xb5\x61\xcb\x47\xb6\xe2\xa4\x66\x42\x26\x89\xa8\x37\x4a\x5b\x42\x93\x41\x5a\x56\x6c\x95\xe2\x6f\x6d\xf1\x67\x25\xec\x7a\xbb\xc8\x97\xaa\x1e\xd7\xc2\x2e\xd7\xbc\xaa\xd6\xe3\x95\xfa\x79\xad\x6a\x5e\x08\x8d\x2e\x42\x8d\x85\xda\x5a\x51\xe1\x83\x32\xf8\x77\xc3\xec\x7a\x5c\x8a\x8a\xe3\x3f\x68\x30\xcf\x66\xc9\x2a\xf0\xc8\x92\x64\xc7\x34\xf9\x1d\xc2\x2f\x84\x26\x33\xb2\x6e\xfe\xa3\x99\xb3\xdf\x6d\xcd\x5a\x9c\xab\x27\x7c\x67\xac\x16\x72\xe5\xcc\x7f\x71\x6d\x84\x92\xa6\x67\x3e\x67\x86\xf7\x4d\x42\x46\x96\xa4\xdc\xca\xa5\xeb\x91\x66\xe4\x25\x19\x28\x93\x5f\x3e\x09\x4b\x35\x67\xd5\x8d\xb3\x66\xc9\xde\x7b\x75\x36\x22\xa4\x45\x6f\x51\x12\xae\x35\x99\xce\xc0\x20\x2c\xa4\x35\x34\xfb\xd5\x99\x7e\x9a\x11\x29\x2a\xf4\x19\x68\x6e\xb7\x5a\xa2\x55\x69\x97\x3b\x0d\xce\xa4\x64\xc0\x40\x41\x16\xcf\x64\xf8\x71\x97\x8e\xd0\x27\x4b\x06\x50\x6f\xb0\xac\x8b\x11\x61\x7a\x65\x46\xa1\xc2\x86\x69\xc3\xcf\xc0\x02\x44\x60\xe1\x0f\xbb\xa6\xe5\xb8\xc6\x04\x83\x03\xaa\x37\x21\xb4\xd9\xde\xc0\xd0\x75\x07\x53\x5d\x3e\x5e\x81\x9f\xb9\x96\xa5\x3a\xd2\xa3\x77\x9d\x01\x47\xc6\x2a\xcd\x9d\xef\x11\xbf\x23\x48\xe2\x88\x57\xc0\x60\x53\x08\xe8\x61\x44\x1e\x46\xbe\x10\x7f\xe2\xcb\xdf\xea\x82\xb6\x44\x65\xa7\x35\xee\xe3\x5e\x6d\xbb\xa3\x32\x8c\xbd\xdd\x3f\xbf\x33\x98\xd8\x2f\x77\x3b\x1c\xff\x9c\x37\x7b\x7a\x04\x48\x84\xc0\xe7\xfb\x21\x02\x9f\xb3\xc5\xd1\xad\x58\xd3\x0f\xe6\x8d\x4f\xc3\x8c\x84\xf3\x94\xff\xa1\x60\x4b\xfd\x09\x1a\x91\x34\x37\xe8\xb6\x50\x4f\x29\x14\x88\x4f\x4a\x3f\x24\x4a\x07\x61\x7e\xc1\x0c\x44\x25\x03\xdf\x28\x9c\x8f\x9b\x47\x40\x75\x56\x55\x87\xde\xf0\x02\x47\x78\xa3\x0a\x4e\x27\xbf\x7c\xfe\x9c\xfd\x60\x1e\xae\xd3\x66\x94\x71\xce\x08\xdd\xff\xcb\x99\x0c\xc2\x99\xef\x37\x77\x90\xda\x3f\x40\x42\xaf\x07\x7d\x6f\x9f\x05\x68\x58\x08\x89\xbc\xf9\x32\x50\x15\x27\x82\x4a\x12\x0e\x20\x84\x82\x24\xe6\xe7\x4a\x55\x34\x90\x06\xf3\x2c\x59\x65\x38\xc4\x9b\xb5\xfa\x3b\xf8\x22\x95\x6e\x98\xd1\x69\x26\xb8\xc3\x7e\xb7\x9a\x55\x26\xf7\xf3\xf0\x88\xbd\xba\x69\x3b\x75\x6a\x97\x3d\x86\x8b\x48\x29\x50\x85\xd9\xee\x27\xf3\xcc\xf1\xe6\x1e\x3e\x4d\xe7\xc9\xe0\x43\x04\x13\x11\x39\x0a\xb1\xe2\x6c\x06\xd8\xc2\x62\x38\x26\x5d\x1b\x7f\x1a\x54\x7d\x70\x06\x98\x8d\x26\xc2\x8b\xda\xe6\x5f\x01\x90\x2d\x69\xea\xde\x4f\xc9\x10\x50\xaa\x8d\x45\x42\xe7\x04\xc4\xbf\x66\xb2\x70\xd0\xf2\x3c\xff\x2e\xbf\x23\x01\x50\x24\x73\xd1\x98\xd6\x85\x5f\xf0\x92\x6d\x2b\xeb\x24\xcc\xcd\xca\xbf\x43\x32\x28\x6e\xd9\x7f\x84\xed\xa0\xf4\xd0\xb8\xbc\xdd\xec\xc2\xf0\x43\x36\x88\xae\xb8\xa4\x4e\x0e\xb0\xbf\x89\xcf\xd1\xf6\xe5\x0a\xfb\xb5\xc3\xcc\x97\x48\x2d\x64\xae\x85\x31\x78\xb4\x31\x32\x75\xe0\x08\x07\xae\x9a\xf0\x03\xd6\x31\x55\x33\x37\xe0\x7a\x74\xf0\xec\xe8\x6e\xb4\x2a\xe0\x0a\x67\xb7\x2f\xa0\xdd\x09\x2e\xe1\x07\x44\x4d\xb2\x9a\xe3\xf9\xd2\x4c\x02\xfb\x67\xc6\x70\xfb\x05\x4c\xc6\x4f\x80\xa1\x41\x40\x68\xab\x38\xce\xc5\x25\xc3\xd0\xac\xd3\xe0\xe3\x6a\x1b\x18\xc2\x8d\xc1\xf0\x57\x96\x3d\xe4\xc2\xd7\xd7\x71\x39\x58\xa9\x3b\xcb\x2c\xc5\x98\x93\x8b\x81\x53\x0b\x3c\xbf\x13\xff\x00\xfd\x18\x11\x92\x07\x53\x1c\x1d\x0f\xc5\x91\x46\x30\xd8\xd3\x35\x34\x53\x62\x20\x86\x08\x43\x0a\x51\x96\x5c\x73\x69\x61\x21\x02\xa8\x7d\xbf\xa6\x53\x8e\xc3\x9a\xde\xf4\xae\x9a\x35\xc4\xbc\xa7\xe6\x37\x51\x1f\x29\xeb\xad\xef\xab\x6c\x21\xe8\x8d\xd2\xfb\xbe\x2e\xf9\x0f\x95\xf8\x12\xee\x76\xcd\xf2\x7a\x73\x11\xdd\x5a\xcd\x47\x59\xfe\xad\x31\xd3\x14\x45\x0b\x10\xdd\x6d\xfc\x79\x0b\xea\xf0\x30\x34\x0f\xd1\xb9\x3b\x45\xd9\x0b\x0e\x70\x63\x0d\x81\x05\xba\xe5\xb5\xda\x71\x54\x79\x0f\x04\xaf\x3b\x1a\x2e\x97\x19\xb9\x6d\x60\xbb\xcd\x36\xb4\x05\x9b\xa6\x27\xc9\x7e\x7b\x97\xdc\x72\xdc\xe2\x2e\xde\x2f\x77\x93\x04\xde\x5f\x9b\x2f\xca\xc2\x55\x6c\x2c\xc5\x2b\xf7\x68\x32\xff\xdc\x3f\xb6\x41\xf9\xf1\x23\xe2\x0a\x99\xf6\x32\x09\xe2\x30\x89\x15\x7c\x07\x14\xcb\xdd\xae\xd5\x71\xd0\x78\x63\x0b\x60\x7b\x04\x5e\x05\x22\xbd\x9f\x2f\x9e\x2d\xef\xeb\xbb\xff\x08\x86\x4f\x50\xde\x24\x8d\xb3\x75\x77\xd0\x78\x4c\x24\x07\x95\x24\xe8\xcc\x8b\x76\xee\xd1\x37\xd1\xf1\xfb\xe4\x54\x1c\x90\xe0\xeb\x71\xa1\x70\x97\x65\x90\x76\xc4\x86\x3e\x6c\xb3\xe1\xb2\xa0\xa1\xca\x8b\x0f\xdf\x8f\xc2\x85\x00\xbe\x8e\x8f\x46\x48\x2e\xe5\x4e\x68\x25\x69\xd7\x50\xe0\x93\xfa\xc8\xc3\xb6\xdb\xf6\xda\x6f\x28\x10\xcd\x9a\xd9\xae\x47\x02\x35\x60\x65\xb9\x2e\xd9\x92\xbf\xec\xdb\xef\x73\xdc\xe6\x2b\xbf\xcd\x4e\xc1\xb0\x67\xd8\x28\x77\xe6\xa6\x24\xfd\xd8\x24\xfa\x98\xba\x7b\x85\x35\x48\x3d\xa6\x4f\x50\xf7\xdf\x00\x00\x00\xff\xff\x9a\xb3\x11\x29\x04\x0d\x00\x00")
+var _audit_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x57\xfd\x6e\xdc\x36\x12\xff\x5b\x7a\x8a\xa9\x70\x69\xa5\x46\x96\xed\x24\x08\x70\xae\xb7\xc0\xc6\xde\xdc\xa5\xb8\x38\x81\xd7\xbe\xde\x21\x0d\x6c\x5a\x1a\x69\x79\x91\x48\x81\xa4\x76\xed\x3a\x7e\xf7\xc3\x90\xd4\xc7\xda\xdb\x20\x41\x92\xa5\x38\xc3\xf9\xfc\x71\x66\xd8\xb2\xfc\x0b\xab\x10\x1a\xc6\x45\x18\xf2\xa6\x95\xca\x40\x1c\x06\xd1\x4d\x57\x72\x19\x85\x41\x84\x22\x97\x05\x17\xd5\xfe\xff\xb4\x14\xb4\x51\x36\x86\x7e\x1c\x55\x6a\xf7\xff\x7e\xa7\x51\xd1\xb2\x65\x66\xb5\x5f\xf2\x1a\x69\x41\x1b\x0a\x2b\xbc\x6d\x69\xa5\x8d\xca\xa5\x58\xfb\x25\x17\x95\x3d\x6b\x78\x83\x51\x98\x84\xe1\xfe\x3e\xb0\xae\xe0\xe6\xb7\xe5\x87\x33\xe0\x1a\x14\xb6\x35\xcb\xb1\x80\x9b\x3b\xd0\x9d\x5e\xf1\x86\x69\x83\x0a\x36\xdc\xac\xc0\xac\xd0\x71\x83\x46\x63\x48\x16\xc8\x12\xf6\x72\x29\x4a\x5e\x65\x24\x6b\x2e\x3c\x43\x2d\x2b\x12\xf7\x05\x5b\x03\x9b\x15\x0a\xe0\x86\xbe\x35\x1a\x90\x0a\x96\x97\xcb\x7f\xbe\x7b\xf3\xe1\x3f\x57\xf3\xcb\xd3\x77\x17\xb3\xc3\x2c\x5c\x33\x35\x31\x64\x06\x51\x14\x86\xb9\x14\xda\xb8\xdd\x85\x58\xd3\xe6\xf6\xb9\x81\x25\x0e\x03\xcb\xf5\x96\xd7\x08\xe3\x9f\x19\x44\x76\x3b\xab\x65\x15\x79\x96\x73\x69\x98\xe1\x52\xe8\x9e\xe5\x65\x18\x14\x58\xb2\xae\x36\x73\xa2\xbf\x67\xb7\x4b\xfe\x27\xc2\x0c\x0e\x0f\xe0\xf8\x18\x5e\x1c\x50\x94\xcc\x5d\xeb\x3d\x3f\xb1\xbe\x82\x36\xaa\xcb\x0d\xdc\x87\xc1\x39\x16\x2c\x37\x00\x9f\x3e\xbb\xf0\xc2\x35\xa5\xec\x28\x52\x76\x3f\x95\x0d\x37\xd8\xb4\xe6\x2e\xba\x0e\x83\x5e\x38\x17\xe6\xf5\x2b\x00\xe8\x79\x1b\x76\x7b\xa5\xf9\x9f\xb8\xc5\xfd\x30\x55\x7b\x8e\xb9\x54\xc5\x44\xed\x05\x6f\x9c\xab\x5e\xeb\x20\xcb\x66\xf6\x3a\x0c\x2e\x35\xaa\x9d\x0c\x16\x33\xd7\x61\x70\xb2\x29\x60\x27\x43\xbe\x29\x88\xfe\xa6\x13\x45\x8d\x3b\xe8\x37\x96\xb0\xed\xd9\xbf\x51\x69\x2e\xc5\x53\xe6\xb5\x23\x58\x85\xb2\x69\x98\x28\x76\x28\x74\x04\xe2\x99\xab\x6a\x6d\x8d\x7a\x1c\x4e\xa6\xaa\x35\x31\x9c\x76\xca\xe6\x0f\xca\x5a\x32\x1b\x45\xcf\x50\x78\x02\x31\x2d\x6e\x29\x51\x85\x0d\xb4\xf3\xd1\x33\xe1\x2d\x37\x57\xb9\x2c\xd0\xc5\xb7\xec\x44\xde\xe3\x8b\xdd\xd4\x58\xc4\x09\xdc\x48\x59\x53\x80\x15\x9a\x4e\x89\x09\x26\x7f\x20\x50\xc2\xd7\xaf\x20\x75\xf6\x0f\x34\x28\xd6\x71\x0f\xcd\x04\x66\x33\x88\x0e\xa3\x41\x66\x2d\x59\x31\x1f\xe1\x12\x27\x10\x4f\xd0\x93\x02\x2a\x25\x55\x42\x6a\xf2\xb2\x82\xa3\xd9\x14\x5b\xf7\x1e\x25\x47\xb0\x03\x97\x0f\x61\xc0\xcb\x27\x46\xdd\x87\x01\x6d\xa3\x52\x24\x8b\x5c\xcd\x2e\x45\xc3\x94\x5e\xb1\x3a\xfe\xf4\xf9\xe6\xce\x60\x3c\x9c\x49\x52\xf8\x31\x2f\xab\xe4\x17\xcb\xff\xc3\x0c\x04\xb7\x0e\x07\xbd\xcb\x79\xe9\x2c\x0c\x83\xe0\x21\x0c\x9c\xc6\xbc\xac\xb2\x1e\xbd\xc7\x33\x38\xb0\x07\xa6\x9b\xb3\x5d\xd6\xda\xd3\x53\xa9\x82\xd7\xdb\x71\xff\xc8\xcc\x2a\x4e\x7a\x44\x8c\x61\xef\x6b\x59\xf6\x9b\xe4\x22\x5e\x52\x21\x7a\x23\x6f\x4f\xb9\x4a\x61\xb8\xe9\x09\x89\xda\xdf\x07\x77\xd3\xe6\xaa\x1a\xaa\x97\x86\xcd\x8a\x19\x40\x96\xaf\xa0\x65\xc6\xa0\x12\xd0\x30\x93\xaf\x50\x03\x17\xc0\x54\xa5\x33\xb8\x58\x21\x94\x5c\x69\x43\x32\x74\x77\x63\x19\x52\xa0\xf0\x8a\xbb\xb4\x2f\x5e\x29\x68\x09\xd7\xf1\xde\x5e\xcb\xb4\xde\x48\x55\xcc\x92\xec\xe7\x6b\xa8\x91\xad\x51\xdb\x82\x58\xd6\xac\x02\xc1\x1a\xcc\x9c\x5f\xa3\x39\x31\x29\x1a\x90\x9c\xf6\xa6\x8c\x5b\x09\xc4\x23\x75\xc4\x84\xec\x8c\xc5\x44\xdb\xa2\x28\x06\x8e\xfb\x87\xd4\x59\x9e\x65\x49\x18\x94\x52\xc1\xd5\x20\x92\xd8\x15\x13\x15\x8e\x3a\x28\x41\x0a\xd3\x1e\x14\xae\x21\x64\x27\xb2\x69\x79\x8d\xb1\x67\x4b\x46\xe0\xec\x00\x82\xe0\xf5\x04\x08\x56\x25\x1f\x35\x91\x95\x96\x5b\x76\xe6\x13\xff\x0c\xa4\x23\x3b\x77\x09\x98\xd7\xf5\xd2\x1a\x1d\x3b\x62\x0a\xd1\xdf\xee\x0f\x1f\x3e\x9d\x2f\x4e\xe7\x27\x17\x8b\xd3\xcf\x51\x32\xa0\xcb\x2b\x93\x9d\xd9\xc6\x47\xde\x29\x85\xc2\x50\x1d\xdb\x42\x08\x2f\xa1\x1b\xdc\xa2\x62\x96\x9d\x38\xce\xd8\x43\x7a\x36\x7a\xe2\x65\x77\x19\x49\xa1\x1c\x6d\x69\x1c\xee\x71\x74\xb9\x5c\x9c\x47\x3d\x9e\x36\x8a\x1b\xb4\x30\xf6\x29\xd0\xc0\x40\xb9\x02\x2c\x4b\x60\x50\x72\xc1\xf5\x0a\x0b\xf0\x85\x0b\x8c\x9c\xb4\xc6\x5a\x56\xa9\x85\xa5\xed\x34\xa2\x02\x6e\x1c\xce\x86\x3e\xb8\x62\x1a\x2a\x25\x37\x02\x5a\xa6\x8d\x3d\xea\x5a\x68\xa7\xb0\x00\x6a\x04\x1e\x4a\xa3\x25\x71\xde\x14\xd0\x23\xe5\x11\xac\xf2\xcd\x48\xd2\x86\x29\x03\xd4\x03\x32\xea\x10\x2e\x4e\x1e\x59\xf6\xc7\xd7\x9c\x94\xee\xe3\xc2\xc5\xf0\x49\xb1\xea\x6f\xfc\x62\x1b\x16\xe3\x45\x5e\x10\x26\x1e\xc2\x80\x8a\x72\xea\x01\xbf\xe8\x71\xb6\x85\x7e\xab\x27\x73\x3d\xd2\xc9\x1d\xb9\x9f\x8a\x1e\x68\x56\x3a\x17\xa5\x4c\xe1\xaa\x37\xf1\x4d\xc7\xeb\xe2\x9d\x28\x25\x19\xe8\xd3\x71\x34\x9b\xb6\x47\x92\x44\x5e\x1f\xf9\xb6\xc6\x94\xc9\x2e\x2f\x4e\xe2\x24\x7b\x2b\x55\xc3\x4c\x6c\xe3\x72\xfe\xf6\xe4\xe5\xcb\x97\x7f\x3f\x63\x42\x26\x69\x18\xd8\x46\xe9\x4e\x6c\x21\x8e\x48\x27\x9b\xc2\x51\x28\xc6\xb4\xe1\x7a\x22\xed\x91\x71\xd9\x19\x6b\x90\xb6\x7d\xf7\x3b\x02\xf0\x2b\x7b\xd8\x81\xe3\x08\x20\x6f\xec\x61\xea\x6d\x4e\x9c\x8d\x5b\x18\x0c\xcd\xec\xc8\x65\x6c\xc9\x45\x8e\xb1\xb5\x3b\xc9\x96\x98\x4b\x51\x68\x67\x48\xdf\xd0\x8e\x80\x3a\xd8\xd2\x30\xd3\xe9\x18\x95\x22\xe2\x43\x18\xd4\x5c\x60\x6a\xeb\xfe\x62\xd2\x02\xde\xfb\x06\xe0\x62\xe5\xa2\xdf\xf3\x3c\x8d\xbd\xa7\x90\xbc\x70\xd2\x4c\x2c\x8a\x7b\x0c\x8e\xf5\xfe\x69\xf7\xf0\x62\xd0\x27\xaf\x4c\x41\xb6\xd8\xdb\x23\x75\xf6\xa1\x45\x41\x55\x3b\x9e\xd4\xfd\xd4\x12\xae\x7e\x3f\xff\x70\xf6\xaf\xff\x7e\xb5\xeb\xf9\xc7\x8f\x8b\xb3\x53\xb7\x3e\x39\x5f\xcc\x2f\x16\x96\x89\x4e\xbe\x97\x05\xc6\x07\xaf\x0f\x0e\x12\xe7\x4b\x2f\xff\xa9\x11\x9e\x62\x0d\x29\xb0\x44\x05\x65\x76\x52\x4b\x8d\x04\x9d\xab\xd4\xdd\x2b\x6f\x5a\x99\xfd\x4e\x5f\xb1\xaf\xb6\x2e\x94\x3f\xfd\x21\x7e\x4a\x92\xa1\x52\xf4\xfc\x7d\xc3\x19\x63\x02\x7a\xc5\x4b\xa3\x61\x98\x33\xc1\xc8\xf1\x23\x3b\x04\x2a\x0f\x5a\x82\x14\x20\x45\x8e\x7e\x0a\xae\x99\xaa\x50\x91\x28\xb3\x62\xd4\x9a\x6c\x4c\x53\xf8\x82\xd8\x52\xc5\x78\x34\xa9\xca\xba\xb0\x0d\x51\xf7\x1d\x66\x92\x93\x66\x3a\x52\x4e\xee\xb8\xbb\x3c\x38\x84\x9f\x30\x33\x0d\xbd\x0f\xa1\xce\xde\xe9\x33\x69\x16\xb7\x5c\x1b\x0b\xa8\x69\x18\xa9\x1a\xfb\xfe\xff\xed\x64\xf3\xd2\xdd\x07\xb2\x24\x4e\xe0\xb8\xf7\x68\x97\xb0\xa1\x8b\x3c\x72\x72\x0f\x0e\x7f\x01\x0e\xbf\xce\xec\xef\xde\x9e\x3d\x2b\x75\x76\x8e\x54\xb7\xa7\xa6\x3f\x8f\xb2\xe8\xb9\x7f\xd6\x64\xef\x8c\x64\x31\x4f\x52\xf8\x36\xc3\xf3\xc3\x24\x79\x54\xfb\x9f\x0a\x7e\x2c\xe4\x30\x1a\x47\x0c\x5f\x22\xc1\x9d\x77\x9d\xdf\x5d\x2c\xfb\x06\xc2\x35\xaa\x3b\x77\xdc\xa6\x2a\xa5\xa4\xa1\xf6\x95\x3f\x25\x16\xb3\x42\x12\x55\xf1\x35\x8a\xa1\x73\x48\x45\x24\x56\xd7\xfd\x8e\x76\x5d\x82\xca\x3d\xd7\x10\x45\xc3\x50\xc1\x8a\xc7\x8d\xc0\x4e\x0f\x93\xf2\x37\x1d\x21\xe8\x39\xd5\x9b\xb7\xc5\xf4\x97\x09\xf0\xc1\x3f\x18\x83\xdf\x0e\x4c\x2e\x22\x6e\x56\xe0\xf0\xab\x1f\xff\x82\x16\x9e\xcf\x20\xca\x22\x78\x0e\x8f\xd2\xd1\x4f\x0c\x53\x04\x52\x01\x88\x5b\x2f\xe5\x2f\x80\x17\xe4\x52\x18\x2e\x3a\xf4\x02\xbe\x7b\x36\xd1\x39\x13\x02\xad\x2a\xfb\x86\xce\xce\x70\xb3\x74\x7b\x71\x99\x8c\x0c\xd9\x9b\xae\x2c\x51\xc5\x0d\xfb\x82\x7e\x32\x4e\xe1\xf5\xab\x9f\x0f\x0f\x5e\xbc\x4a\x52\x38\x3c\x3e\x7e\x71\x90\xf8\x59\xa7\x3f\x42\x72\x62\x6f\x9f\x8d\x2b\x6c\xc5\xf3\x1b\xa3\xf7\xa0\xf4\xce\x20\x55\x72\xf8\x51\xed\x18\xbb\xa7\x4e\x07\x0f\x5e\x20\xa5\x79\xd6\xbf\x38\x54\xd6\xbf\x9d\x66\x33\x4b\x71\xe7\xfa\x04\x0f\xa3\xa2\xdf\x48\x41\x25\xbd\x2c\xfa\x67\x87\xa2\xc1\x9d\x85\x52\x36\x97\x93\x92\xf8\xdd\x71\x1e\x2f\xd0\xa0\xca\x8f\x6c\xfb\xfb\xd0\x2a\x2e\xdc\xf8\x0f\xbc\x69\x6b\x6c\x50\x18\x0d\x7b\xee\x52\x7c\xf2\xf8\xfe\xec\x01\x3d\x32\xc7\x1b\xe0\xd2\x15\x61\x95\xc2\xd6\x9c\xa3\xed\x1b\x87\x5e\x64\x93\xca\x36\x68\x1e\x26\xdb\xc9\xd5\x48\xbe\xa3\x5a\xf9\xd1\x59\x8d\xa3\x6c\x1f\x48\xff\x8e\xf2\x7a\xe9\xcb\xb7\x56\xdc\xd5\x56\x93\x49\xee\xb7\xf2\x39\xd5\xe7\x33\x5a\x36\x26\x7b\x6b\x7d\x2e\xe3\x4d\x0a\xd1\x33\xfd\x87\x88\x52\x20\xe9\xc9\x0e\xdc\x3f\x65\x07\xf7\x97\xda\xff\xec\x59\x01\xcf\xb2\x97\xa5\xdf\xb3\x82\x94\x1f\xf7\x94\x9d\x74\xe9\xb7\x9f\x44\x40\x65\xfd\xf4\x40\xeb\x7e\xe4\x48\x47\x50\xa5\x3e\xe0\xda\x3d\xb6\x54\x36\xb7\x63\x5d\x04\xd1\x76\xcd\x74\x99\xfe\xff\x00\xcb\xed\x75\xd3\xbc\x12\x00\x00")
+
+func audit_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_audit_go,
+		"audit.go",
+	)
+}
+
+func audit_go() (*asset, error) {
+	bytes, err := audit_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "audit.go", size: 4796, mode: os.FileMode(420), modTime: time.Unix(1792409202, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _buildinfo_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x74\x54\xcb\x6e\xdb\x3a\x10\x5d\x8b\x5f\x31\x21\x70\x2f\x24\x5c\x46\xc6\xed\xd2\x85\x17\x0d\xd0\x47\xba\x48\x8b\xba\x8f\x45\x1a\x24\xb4\x35\xb2\xd9\x48\xa4\x41\xd2\x36\x0c\xc3\xff\x5e\x0c\x45\x5a\x52\xea\x06\x41\xa4\xe8\xcc\x83\x73\xce\x1c\x6e\xe4\xf2\x59\xae\x10\x5a\xa9\x34\x63\xaa\xdd\x18\xeb\x21\x67\x19\x47\xbd\x34\x95\xd2\xab\xc9\x2f\x67\x34\x67\x19\xaf\x5b\x4f\x0f\x65\xe8\xaf\xdd\x6a\xaf\x5a\xe4\xac\x60\x6c\x32\x81\xc5\x56\x35\xd5\xad\xae\xcd\xc7\xf9\xa7\x3b\x50\x0e\x2c\x6e\x1a\xb9\xc4\x0a\x16\x07\x70\x5b\xb7\x56\xad\x74\x1e\x2d\xec\x95\x5f\x83\x5f\x23\xb4\xe8\x65\x25\xbd\x04\x53\x87\xff\x43\x85\x92\xed\xa4\x7d\x51\x6c\x06\x9c\x33\xe6\x0f\x1b\xec\x01\x70\xde\x6e\x97\x1e\x8e\x2c\xbb\x93\x2d\x42\xfc\x71\xde\x2a\xbd\x82\x27\x3a\xf1\x94\x6b\xd9\xa2\x30\xad\xf2\xd8\x6e\xfc\x81\x3f\xb1\xec\x3b\x5a\xa7\x8c\xbe\x10\xbb\xeb\x90\x71\xf8\x0d\xb5\xbb\x58\x3a\x1c\xe4\x1c\xf2\x55\xb5\x78\x39\xe4\x91\x38\xba\x50\x14\xed\xdf\x8a\xa2\x1d\x87\xcf\x07\xdc\x8d\xc3\x07\xac\x8e\x53\xde\x9b\x7e\xce\x71\xca\xca\x3c\xc6\x41\x29\xee\xb3\x3c\x34\x46\x56\x17\x4e\xb2\xe9\x90\x71\xd9\x37\xce\xa1\x77\x21\x1a\x94\xf6\xf4\x88\xe1\x32\x20\x14\xf3\x05\x77\x2a\x31\x3c\x2e\x69\x23\x32\xaa\x79\x62\xac\xde\xea\x25\x50\xb3\x9b\xa4\x6d\x5e\x40\x7e\x16\x5a\x00\x5a\x6b\x6c\x41\x4a\x2b\x12\x7e\x3a\xeb\xb7\xe0\x18\x72\xa6\x10\xc7\x15\x10\x27\x9a\xa6\x97\x0f\xd2\xad\x05\x74\x07\x9f\x42\x83\x3a\x0f\xef\xb4\x32\x2e\x2f\x8a\x13\xcb\x54\xfd\x62\xdb\xae\x68\xdd\xa8\x1b\x41\x68\x2d\x35\xa4\x09\xca\x6f\xba\x95\xd6\xad\x65\x93\xdf\x3f\x2c\x0e\x1e\xf3\x51\x5e\x21\xe0\x5f\x3a\x5f\xf1\x3a\x24\x5d\xcd\x40\xab\x26\x94\xc9\x2c\xfa\xad\xd5\xa0\xd2\x34\x2c\xcb\x4e\x8c\x7e\xe9\x4b\xd9\x8b\x35\x83\xe8\xa9\x32\x7e\xc9\x0b\x36\x4e\xd6\xaa\x21\xca\x26\x13\xd8\x58\xa5\x7d\x4a\xdc\x5b\xe5\xd1\xf5\x26\xea\xad\x55\x1b\x0b\xd7\x69\xb5\xc1\x79\x69\x3d\x49\x92\x3c\x78\x36\x2e\xc4\x18\x30\x1a\x24\x34\x4a\x23\xd9\x56\x79\x87\x4d\x5d\x76\x12\x0d\x3b\xe6\x7b\x50\xa6\xfc\x41\x6d\xad\x00\xe9\x02\x71\x0b\x63\x9a\xa2\x53\x2b\x89\x25\x12\x81\x2f\xf4\x65\x89\xdb\x01\x4d\x71\xd0\xc0\x4f\xa7\x4b\xac\x4b\x20\xea\xe5\x59\x87\x3b\xdc\xbf\xa5\x9b\x09\x6d\xbe\x2f\x3a\xac\x9c\xa3\xbf\xd5\x15\x6a\x9f\x73\x2e\x80\x03\xf0\x62\x50\x52\x2f\xcb\x2e\x23\x0f\x0a\x51\x7d\x96\xd5\xad\x2f\xdf\x85\xa1\xea\x7c\x2f\x80\xff\xe3\x7e\x6a\x2e\x02\xd1\x65\x38\x6a\xc1\xb2\x5a\x61\x53\x39\xea\x7c\xff\xd0\x5d\x38\x47\x78\xc6\x83\x80\x9d\x6c\xb6\x98\x16\xfc\x44\x27\x3c\x86\xdb\x26\x15\xa0\x05\x3b\x89\xf0\x39\xf9\x2d\x22\x91\xc1\x08\x12\xf9\x7e\xd4\x95\xae\x92\x01\x58\xa1\x1d\xc1\x68\x23\x38\x30\x7f\x0a\x18\xdc\x14\x31\x68\x65\x12\x76\xde\xb2\x88\x44\x83\x27\x38\xda\x25\x82\xd1\xce\x02\x88\xa3\x79\xe0\x28\x30\x57\x76\x46\x2a\x62\x58\x72\x74\x2a\x92\xbc\x4f\xf0\x89\x65\xb4\x7b\x8f\x02\x6a\xa2\xcf\x4a\xbd\x42\x88\x74\x46\x6f\xd5\x65\xc7\x62\x6f\xb8\x3f\x35\xb9\xfe\xff\x95\x83\xa8\x4c\x5d\x3e\xe3\xe1\x3f\x3e\xe5\x22\xa5\x16\x67\x27\x45\xa5\x3b\x7f\xfc\x1e\x00\x96\x9a\x94\x99\xd1\x06\x00\x00")
+
+func buildinfo_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_buildinfo_go,
+		"buildinfo.go",
+	)
+}
+
+func buildinfo_go() (*asset, error) {
+	bytes, err := buildinfo_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "buildinfo.go", size: 1745, mode: os.FileMode(420), modTime: time.Unix(1792408980, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _commands_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x7c\x55\x6f\x6f\xdb\x36\x13\x7f\x6d\x7d\x8a\x8b\x50\x3c\x95\x10\x45\x7e\xf6\x36\x85\x5f\xac\x49\x87\xb6\xc0\x96\x21\x5e\x80\x01\x46\xd0\x32\xd2\xd9\xe2\x2c\x91\xc2\x91\x52\x23\x04\xfe\xee\xc3\x91\x92\x22\xba\xed\x0c\xc3\xb2\xc8\xbb\xdf\xdd\xfd\xee\x5f\x2b\x8a\xa3\x38\x20\x34\x42\xaa\x28\x92\x4d\xab\xc9\x42\x12\xad\x62\x54\x85\x2e\xa5\x3a\xac\xff\x31\x5a\xc5\xd1\x2a\xd6\x86\x7f\x5b\x61\xab\xf5\x5e\xd6\xc8\x7f\xf8\xc0\x68\xb2\xee\x69\x49\xaa\x83\x89\xa3\x34\x8a\xd6\x6b\x28\x74\xd3\x08\x55\x9a\xcf\xdb\xbb\x3f\x40\x1a\x20\x6c\x6b\x51\x60\x09\x4f\x03\x98\xce\x54\xb2\x11\xc6\x22\xc1\x37\x69\x2b\xb0\x15\x42\x8b\x74\x35\x2a\x81\x41\x6b\x19\x8c\x81\x0e\xb2\x47\xc5\x5a\x57\x85\x56\x7b\x79\xc8\xa3\x5e\x50\x08\xbf\x81\x38\x76\x46\x49\x6b\xfb\x41\xf5\x6c\xcf\xa0\x85\xbd\x26\xc0\x1e\x69\x98\xc4\xc1\x6a\x67\xab\x94\x84\x85\xd5\x34\x78\xcb\x62\xa8\xb5\x28\x41\x3a\x7b\xf8\x6c\x49\x14\x16\x59\x38\x87\x37\x2f\xdb\x87\xed\xc7\x4f\xef\xef\xfe\xfe\x72\x7f\x77\xf7\xd7\x09\xa4\x9a\xbd\x03\x7c\x6e\xd9\x87\x09\xd5\x88\x06\xf3\xa8\xd0\xca\xd8\xd9\x93\x0d\xc4\x01\x40\x1c\x45\x76\x68\x71\x72\xe8\xc6\x85\x04\xc6\x52\x57\x58\x78\x89\x56\xac\x03\xd0\x88\x76\xe7\xf9\x7c\xf4\x0f\xf8\xca\x59\xb8\x8e\x51\xf5\x99\x6e\xa4\xc5\xa6\xb5\x43\xfc\x35\x5a\x3d\x28\x0e\x74\x37\x89\xcd\x9f\x51\xbe\xe3\xeb\x50\xe3\x56\x12\x00\x9c\xcb\xcf\x1a\xa5\xa4\x50\xfe\x77\x5d\xe2\x7f\xc8\x37\xba\xc4\x40\xe1\x14\x45\xfb\x4e\x15\x53\x84\xdb\x91\xac\xa4\x68\xca\x11\x25\x85\x24\x08\x3f\x03\x24\xd2\x94\x72\xfc\xcb\xdc\x2e\x69\x08\x14\xa2\x95\xdc\x87\x15\x70\xc1\x25\xc0\xfa\x7c\x83\x44\x70\xbd\x01\xf6\x2f\x7f\x50\x8d\x20\x53\x89\x3a\xd9\x3d\x3e\x0d\x16\x93\xa5\x5a\x9a\xc1\xff\xa6\xf7\xf4\x9d\xd3\xbb\xd8\x80\x92\xb5\x43\x5a\x11\xda\x8e\x54\x98\xaa\x97\x93\xf3\x36\x5a\xad\x4e\x11\x7f\x43\x19\xb3\x2b\x9a\xf2\x31\x63\x88\x99\x07\x5f\x24\xf7\x5a\xdb\xc4\xcc\x04\xf8\x27\xbc\xcc\x00\xfe\xc0\xe4\xf7\xbe\x4d\x12\x93\x41\xfc\xe6\x25\xbe\x1c\xeb\xe8\x32\x3e\xc5\x19\xbc\x17\x06\x6f\x25\x65\x70\xf5\x4b\xca\xf8\xeb\x35\xd7\x22\x97\x8c\x47\x31\x80\xaa\xef\x7d\x4b\x1d\x71\xe0\x4b\xb0\x1a\x7a\x51\x77\x98\x8d\x1d\xc8\x66\x85\x1a\x00\x05\xd5\x12\xc9\x5f\xe6\xde\x55\x0f\x96\x38\x90\xa9\xa4\x32\x46\xca\xbc\xd8\xec\xfe\xee\xf1\x35\x00\xdd\x59\x66\xbb\x53\x0b\x6d\xa7\x94\xce\xc1\x89\xb6\x45\x55\x26\xba\xb3\xee\xe2\x32\xde\xc4\x97\x0e\x30\x9d\x59\x0a\xd4\x43\xe3\x3f\xb4\xda\x12\xee\xe5\x33\x1b\x66\x89\x4b\x88\x37\xf1\xec\x4a\x23\x8e\x98\xbc\x42\xfc\x3f\x83\x1a\x95\x03\x4e\xd3\x68\xc5\x43\xe1\x4b\x06\xc7\x9e\x45\x49\xa8\x03\x7a\xd6\xc6\xe2\xb9\x98\x32\xf1\x51\x98\x3f\x9d\x91\xe4\xd8\x67\xe0\xed\xa5\xbe\x30\xd8\xce\x26\x8c\xaa\x4f\xcf\x4b\x42\x77\x76\xcc\xd1\x58\x1c\x9c\x27\xd1\xb6\xb5\x44\xe3\xc7\xc5\x34\x46\xf4\x1e\xb8\x39\xac\x76\x8e\x5c\x43\x2f\x48\x8a\xa7\x1a\x0d\x08\x42\x20\x6c\x74\x8f\x65\xc6\x48\xb6\x42\x37\x7e\x32\xe0\x61\xb6\x97\x4a\xd4\xf5\x00\xc1\x80\x19\x67\x5f\x1e\x34\x21\x13\x5b\xc0\x79\xd3\x2d\x99\x0e\xd9\x9d\x38\xc2\xe1\x95\xa4\x22\xf7\xb3\x86\x19\x70\xaa\x3f\xc9\xf9\x29\x5a\x1d\x71\x30\x3f\x4f\x44\x91\x7f\x50\x73\x26\xce\x4c\x30\x47\x6c\xc0\x21\xcc\x14\xf3\xdb\x02\x9e\x37\x4e\xbe\xf5\x69\x72\x77\xe9\x8f\x3d\x76\x20\x0b\x77\xcf\x9d\xcd\x96\xbd\xe9\x6c\xef\x8e\x38\x3c\xa6\xe9\x32\x8d\x81\xd2\xd8\x8d\x73\x27\xa6\x61\x86\x79\xb4\x4e\x9d\x18\xae\x19\xce\x2f\x75\xca\x80\x54\xdc\x88\xb5\xb0\xb2\x47\xd0\x0a\x0d\x3c\xa1\x54\x07\x06\x99\x8f\xc7\x75\xf2\xba\x88\xc6\x05\x95\x81\x26\x1e\x73\x56\xc3\x11\xb1\x75\x42\x85\xa8\x6b\xa4\xb7\x26\xcc\xf7\xad\xa4\xf3\x7c\x2f\x67\x0e\x4f\xcf\x9c\x9d\xdd\xcc\x63\x73\x8c\x36\x8e\x5d\xec\xa5\x74\x13\x74\x5a\xf3\xf9\x6f\xa4\x9b\x6d\x2d\x4c\x95\x04\x8c\x31\x03\xa9\x83\xbb\x98\x45\x3f\x99\x5f\x9f\x4c\x52\x4a\x3f\xce\x1d\xd2\x02\xe8\xb3\x96\x2a\x99\xc7\x18\x4b\x2d\xb9\x2e\x25\x8d\x84\x72\x9f\x0c\x37\xaf\xac\x16\x15\x67\x94\xe9\xfb\x6e\x83\xfb\xee\xc9\xe0\x5b\x25\x8b\x6a\x24\x0e\x8b\xb7\x25\xc3\xb4\xa4\x0b\x34\xac\x56\x21\x49\x3b\xb1\x74\x86\xfe\x3d\x55\x6e\x1f\x8d\x4c\x8d\x5c\x2c\x99\x4d\xdf\xb9\xd3\x8b\x73\xf6\xb4\xc9\x6f\xaa\x52\x52\x72\x1e\x98\xdf\x07\xff\x0e\x00\xb3\xb2\x9a\x4e\x6b\x09\x00\x00")
+
+func commands_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_commands_go,
+		"commands.go",
+	)
+}
+
+func commands_go() (*asset, error) {
+	bytes, err := commands_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "commands.go", size: 2411, mode: os.FileMode(420), modTime: time.Unix(1792409101, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _completion_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbc\x58\x6d\x73\xdb\xb8\x11\xfe\x2c\xfe\x8a\x0d\xcf\x8e\xc9\x9a\xa4\xed\x7c\xab\x5c\xdd\x9c\xeb\x38\x33\xed\x5c\x72\x19\xfb\xae\x99\x56\xa7\xca\x10\xb9\x94\x30\x26\x01\x1e\x00\xca\x76\x1c\xfd\xf7\xce\x02\x7c\x55\x9c\x97\x4e\x67\x9a\x0f\x22\x02\x62\x9f\xdd\x7d\xf6\x01\x88\x75\xc5\xd2\x3b\xb6\x46\x28\x19\x17\x9e\xc7\xcb\x4a\x2a\x03\x81\x37\xf1\xf3\x82\xad\x7d\x7a\x96\x86\x1e\x5c\xd2\xaf\xd4\xee\xf7\x04\x1f\x30\xa5\x61\xc5\xcc\xa6\x7d\x9e\xe4\xbc\xc0\x76\x42\xe1\x1a\x1f\x2a\x1a\x69\xa9\x2c\x82\x36\x8a\x8b\xb5\xf6\xbd\xd0\xf3\x4e\x4e\xe0\x02\x56\xb5\xc8\x0a\xf2\xfc\x08\x7a\xc3\x2b\x48\x65\x59\x15\x68\xb8\x14\xfa\xe4\x2f\x69\x99\xfd\x18\x01\x13\x40\x9e\x6a\xc3\x56\x05\xc2\xfd\x86\xa7\x1b\xe0\x1a\x54\x2d\xe0\x9e\x9b\x0d\x98\x0d\x12\xd6\xbd\x54\x99\x06\xf3\x58\x61\x06\x2c\x37\xa8\xa0\xb1\x37\x1b\x84\x82\x69\x03\x52\x20\xac\x90\x8b\xb5\x9d\xa2\xf5\x60\x64\xeb\x11\xc9\x51\x46\x40\x95\xe2\xc2\x68\xbb\x3a\x65\x22\xe3\x19\x33\x08\x15\x2a\x28\xb8\xc0\x04\xfe\x66\xc8\xf4\x4e\x5b\x10\xcd\x4a\x84\x5c\x2a\xc0\x2d\x2a\x4a\x01\x8b\x22\xf1\x52\x29\xb4\x19\xa6\xf2\x9a\x2b\x98\x81\x3f\x98\xf1\x6d\xfe\xcd\x04\x25\xf6\x8e\x90\x4a\x66\xd2\x0d\x3a\x68\xc1\x4a\xd4\x4d\xba\x4c\x91\xab\x1c\x29\xde\x7b\xc5\x0d\x42\x2d\xfe\xa8\xa5\xc1\x0c\xb8\x30\x92\xa0\xd8\xc0\x1f\xe8\x54\xf1\xca\x24\xde\x96\xa9\xcf\x7c\xcc\xc0\x95\x25\x79\x5b\x6b\x73\x29\xcb\x8a\x17\x18\xdc\xfe\x7b\x7e\x11\xff\x8b\xc5\x1f\x4f\xe3\x3f\x2f\x93\xe3\x78\x71\x7c\x70\xeb\x4a\xe4\x0a\xf4\xce\x06\xa3\xd0\xd4\x4a\x0c\xc3\x93\x39\xb0\xa2\x00\x2a\xba\x8e\x88\xa0\x3b\x4d\x34\x02\xd3\x1a\x8d\x06\x2e\xda\xea\x38\x98\xc4\xcb\x6b\x91\x0e\x31\x83\x10\x4a\x56\xcd\x9d\x2e\x16\x2b\x29\x0b\x78\xf2\x26\x0e\x7c\x3a\x83\x92\xdd\x61\xb0\xb7\x20\xf4\x26\xc4\xf8\x32\xb2\x31\xd0\x2a\xc5\xc4\x1a\xe1\x82\x7c\xb6\xa0\x4f\xde\xc4\xa1\xcc\xe9\x77\x01\x33\x30\xaa\x46\x6f\xb2\xeb\x8c\x07\x96\x25\x13\x3c\x47\x6d\xac\x15\xcf\x01\x93\x5f\x1f\x2b\x84\x17\x33\x40\x61\xd4\x23\x55\x8f\xde\x34\x80\x98\xbc\x1b\x41\x12\xe6\xce\x9b\x38\x72\x1c\x2f\xde\x6e\xc0\x5d\x76\x29\xcb\x92\x89\x6c\xcc\x5f\xda\x4e\xd6\x22\x43\x05\x2b\x2e\x4e\x2c\x73\xae\xe0\x32\xa7\x45\x25\x6c\xd8\x16\x47\xd5\x45\x35\xe2\xb0\xc3\x0e\x42\x08\x3a\xc8\xf9\xc2\xd1\x15\xf5\x56\x7a\x9f\xe6\x70\xc4\xf3\xa8\x22\xde\x64\x60\xf6\xd5\x1a\x8c\x0b\xe0\xd0\x88\xa9\x8c\xab\x08\x56\x4c\xdb\x97\x74\x1a\x24\x37\x55\xc1\x4d\x40\x2b\x42\xc7\x71\xc6\x15\x11\xec\x53\xe2\x3e\x7c\xfa\x04\x2f\xf6\x84\x9a\xbc\xa5\xcd\x70\x63\x7d\x06\x84\xe5\x8a\x3a\x49\xa5\x30\x5c\xb4\xc4\x4f\xba\xa4\x67\xc0\xaa\x0a\x45\xd6\xd1\xe0\x22\x68\xdc\xb9\xd2\x8d\xb7\xe4\xb1\x7f\xe2\x1f\xd3\x9a\x45\x8b\xdc\x66\x3d\x77\xb3\x7b\x15\xa6\x43\x2c\x71\x01\xe9\xce\x4b\xd8\x56\x9e\x6a\x4e\xa7\xcf\x60\x1f\xbe\x29\xd8\x1a\xb4\x51\x75\x6a\x5a\xb6\x23\xa8\x35\x1d\xb5\x8e\x4b\xb2\xb1\xd5\x1c\xdb\xd8\x6a\xd2\xd9\x4b\xa5\x1c\xbf\xb2\x24\xd0\xab\xe4\x1f\x5c\x73\x73\x51\x14\x01\x01\x04\x39\xfc\xc9\xce\x76\x6b\x28\xe9\xdc\x2a\xd5\xd2\xdc\xe6\xe6\xbb\x54\x1d\x78\x47\x99\xfd\x6f\xb4\x17\xc5\x93\x33\x8f\x20\x4f\x7e\xa3\x98\x77\x61\xc3\xc4\x28\x65\x1b\xbe\x3d\x93\x2e\x3b\xeb\xe0\x1e\xb8\x4c\x3e\xd0\xa4\x8a\xdc\xa1\xd8\x24\x1c\x02\x2a\x25\xed\x76\xea\xcb\x34\x50\xdb\x74\xf6\xb9\xb0\xbd\x09\x1d\x63\x19\x16\xb8\x66\x74\xe2\xb5\xea\xee\x76\x72\xda\x4b\xb0\x05\x6d\x19\x18\x94\x34\x6d\xaa\xdc\x03\x75\xe9\x77\x53\x11\xa4\x61\x57\x6e\xf2\xda\x16\x61\xcf\x63\x3e\xf2\xb8\x57\x39\xf2\xf2\x3c\xbf\x7e\xec\x1f\xe7\x49\xb3\x09\x76\x9e\x37\xd1\xf7\xdc\xa4\x9b\x86\x21\xe2\x84\xb6\x8c\xbf\x62\x7a\xe3\x4f\x09\xa5\x34\xc9\x1b\xfb\x39\xca\x83\xfb\x08\x6e\x97\xba\xd6\x1b\xbe\x92\x0f\xd6\x0b\x00\x40\x21\x53\x56\x40\x5a\xab\xd9\xc1\xd3\xe5\x2f\x6f\xdf\x2f\x3f\xfc\x72\xfd\xfa\x66\x6e\x87\x97\x34\x5e\xec\xec\x3a\x9e\xc3\x1c\xfc\x83\x7e\xde\x87\x18\xff\x80\x33\x58\x9c\xd3\x41\x23\xec\x22\xfa\x47\x2b\xae\xaf\xde\xff\xfc\xcf\x59\x70\x40\x1a\xaf\xd6\x28\x20\xfe\x00\xfe\xa1\xf6\x21\x8e\xc1\x3f\x48\x6b\xe5\x87\x61\x67\xd0\x28\x81\x86\x39\xb7\x0f\x9b\xc4\x28\x9c\xb3\xc5\x0e\xb8\x5b\x73\xa8\xc3\xe7\x7d\xf9\x23\x8b\xd3\xc5\xce\x87\xb8\xad\x1e\x8c\x5f\xfe\xb4\x98\x9e\x4d\xfb\x54\x76\x3e\xbc\xfa\xf1\x24\xc3\xed\x89\xa8\x8b\x62\x10\xda\xf9\xb9\x1d\xc6\x7d\x89\xc2\x6f\xe7\x49\xe4\xc3\x47\xbd\x81\x9c\xeb\xcd\x37\x53\x1e\xb8\x41\xcd\x52\x3b\x98\xc3\xc1\xd3\x0f\x1d\xfe\xfc\xa7\xc5\xce\x72\x7d\x0a\x0b\x78\xf9\xf2\x0b\x8e\xf3\x91\xa3\x9d\xd7\x65\x1e\xbf\x81\xae\xea\xd0\x0e\xbc\xdb\xa8\xd9\x4f\x3a\xf9\xbb\xe4\x22\xf8\xec\xe4\xb3\x82\x4b\x92\x24\x8c\xc0\x07\x3f\x8c\x6c\x51\x7e\x66\x2b\x2c\x74\xaf\xf6\x30\x6c\x15\xf7\xf1\x0b\x82\xfb\x81\xe2\xc8\x30\xef\x3d\x7f\x2e\x41\x9e\x43\x10\xc0\xe5\x6f\xd7\xd7\x57\xef\x7e\x85\xd9\x0c\x5e\x41\x18\xee\xa9\x8a\x60\x58\x96\x51\x92\x87\xfa\xdb\xd2\xb1\x57\xb9\xf9\xab\xe7\x55\xe3\x24\x1f\xb3\xfe\x72\xd6\x23\xf6\x53\xb3\xe0\xe0\x29\xc8\x43\xff\x20\x68\xe1\x48\x84\xbd\xa2\x3a\x27\x51\x13\xf9\x62\x37\x52\x91\xbf\xeb\x3d\x06\x01\x55\xb4\xc7\xde\x41\x18\x52\x29\x07\x59\x1d\xf4\x6f\xe9\xcd\xf3\x0a\x79\x56\x88\x03\x90\x91\xf4\xbe\x57\x6d\x4b\x7b\xf7\x6a\x24\x43\xa5\xfa\x3f\xc8\x25\xe7\xcf\xeb\xc5\xef\x65\x9b\x76\xde\x21\x16\xb0\x5c\x92\xc9\xb2\xd6\xb8\xd4\xf5\xaa\xf1\x6b\x55\xcf\xe0\xe8\x50\x1f\xfd\x2e\xfc\xbd\x10\xfb\xd8\x28\x20\x3a\x94\xbf\xf7\xe8\xfd\x5f\x83\x92\x70\xa8\x21\xce\xfa\xb8\xdc\x89\xdd\xc7\x77\x8d\x55\xc1\x52\x0c\xf2\xc4\x7e\xca\x23\xf0\x8f\xfc\x08\x6e\x7f\x3f\xba\x8d\x20\x3e\x0b\x9b\x0f\xc8\x77\x87\x71\xd4\xc4\xa1\x11\xc5\x92\xa9\x75\x5d\xa2\x30\x14\x46\x9f\xde\x51\x4b\xd5\x48\x21\x14\xdd\x80\x98\xc1\x57\xb0\xff\xc4\xfd\x57\x84\x8c\x22\xe9\x29\x59\xe6\x4a\x96\x70\xa8\xbb\x28\x82\xde\xa8\x83\x6a\x0b\x46\x3d\x12\xc4\xb2\x4a\xc3\xf9\xab\x24\x89\xcf\x16\x7b\x6f\x52\x13\x8e\xf6\x99\xa3\xb8\xfd\xea\x66\x98\xb3\xba\x30\x24\xad\xe6\x3a\x4d\xb1\x5f\x29\x25\x55\x1e\xf8\xb5\xd0\x75\x45\xbd\x29\x66\xcd\x27\xf3\x50\x47\x80\x0f\x15\xa6\x34\x45\xe4\x44\x96\x1d\xa9\xdc\xe9\xdd\xdc\x3d\xc2\xd1\xfd\x9c\x17\xcd\xed\xbc\xd7\x78\x77\x31\x67\x0d\x2e\xbd\xa2\x7b\xab\x41\x25\x5c\x53\xd6\x76\x8d\x6b\xbe\x45\xe1\x7a\xcd\x88\xfc\x48\xe1\x9a\x4f\x7b\x6b\x17\xd4\x05\x76\x4d\xdc\xfd\x06\x6d\xfb\xa3\xd0\xf6\x6f\x42\x8a\xb6\xff\x19\xec\x2e\x8b\xd4\x5d\x30\xc2\x46\x64\x54\x37\x9e\x43\x81\xc2\x2d\x08\xe9\x5c\x3d\xb5\xd5\x6c\xd2\xf0\x8f\x8e\xfc\x61\x5a\xa3\xcd\xd3\x84\xe7\x7f\xf2\xc3\x36\xd7\xa6\x4e\x97\x65\x06\xaa\xee\x5b\x10\x3b\xa9\x40\xe6\x90\x96\x59\xd7\x4e\x3f\xd3\x4b\x73\xd3\xb6\xc7\xa9\xac\x78\xd3\xa2\x0e\x0e\x3d\x6a\x4c\x13\xe8\x3a\x1d\x42\x92\xb5\x19\xf6\x2d\xb6\x97\x19\xb1\xd0\xc7\x34\xbe\x30\x52\x28\x6d\x03\xb3\xcf\x4f\x77\x7d\xe4\xb9\x15\xfd\xd7\xaf\x8e\xe7\xf0\x62\x78\x03\x2c\xb3\xc5\x90\x43\x92\x02\x51\x58\x91\x6d\xfb\x77\x0b\xc7\xe0\x5f\x99\xc6\xd7\xd4\xc4\x8c\x3b\x06\x1b\x5b\x68\x9d\xa3\x52\x64\x96\x6e\x30\xbd\xbb\x7a\xc0\xf4\x3d\x33\x9b\xa0\x0a\xcf\xed\x8b\x17\x33\x02\x1f\xfa\x42\xa5\xac\xaf\xde\x72\x8b\x8a\xe7\x8f\x64\xfa\x86\x3a\xf0\x6f\x9b\x32\xb5\x3e\x8d\x80\xa9\xf5\x36\x6a\x31\xb8\x30\xa8\x2a\x45\xe9\x5d\xa8\xf5\x36\xa8\x1a\xc2\xfa\x10\xbf\x0c\xa7\xd1\x18\x92\x4c\x07\xd6\xec\xd3\x9b\x66\x3e\x18\xa5\xfa\x65\x9c\xbe\xc0\xd3\x19\xbc\xa4\xbf\xd4\x24\x97\x65\xf6\x44\x7c\x4c\xc1\xc5\x7c\xa1\xd6\x7a\xda\x44\x7e\x25\xb6\xd3\xd6\xd5\x95\xd8\x06\x7d\x18\x25\x13\x34\x51\xf0\x95\x62\xea\x91\x86\x52\x27\x57\x62\xcb\x95\x14\x41\x18\x86\x61\x04\x37\x26\x93\xb5\x99\xc2\xbd\x1d\xa2\x52\x53\x90\x3a\x71\xc3\x7e\x2f\xf4\xad\xf2\x75\x2d\x02\xda\x02\xff\x19\x00\xdd\xab\xed\x6f\xe1\x12\x00\x00")
+
+func completion_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_completion_go,
+		"completion.go",
+	)
+}
+
+func completion_go() (*asset, error) {
+	bytes, err := completion_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "completion.go", size: 4833, mode: os.FileMode(420), modTime: time.Unix(1792410580, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _crypt_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdf\x6f\xd4\x46\x10\x7e\x3e\xff\x15\x83\x45\xc0\x96\x9c\x3d\x9a\x42\x1e\x42\x4f\x55\x1a\x0e\x1a\x51\x02\xca\x51\xb5\x15\xa0\x68\xcf\x1e\x9f\x57\xb1\x67\xad\xdd\xbd\x84\x6b\x95\xff\xbd\x9a\x5d\xfb\xec\x4b\x08\x84\x07\xe2\x1b\xef\xfc\xf8\xbe\xf9\x76\xc6\xad\xcc\x2f\xe5\x0a\xa1\x91\x8a\xa2\x48\x35\xad\x36\x0e\x92\x68\x12\x2f\xd7\xa5\xd2\x71\x34\x89\x73\xb3\x69\x9d\x9e\x4a\xb4\xa3\x5f\xb9\x6a\x2b\x34\x23\x43\xbb\xbc\x2c\xca\x83\x91\xc1\x56\xf2\xe0\xc5\x21\x1b\x90\x72\x5d\x28\x5a\x4d\x97\xd2\xe2\xe1\x73\x36\x95\x8d\xe3\x3f\x4a\x4f\x95\x5e\x3b\x55\xf3\x0f\x6d\xc3\xff\x53\xfc\x8a\x39\x3f\x5a\x67\x14\xad\x6c\x1c\xa5\x51\x34\x9d\x02\x92\x8f\xac\x34\x2d\x64\xed\x40\x59\x30\xd8\xd6\x32\xc7\x02\x96\x1b\xb0\x6b\x5b\xa9\x46\x5a\x87\x06\xae\x2b\x24\x70\x15\xc2\x72\x4d\x45\x8d\x7c\x74\xb9\x56\xb5\x83\x6b\xe5\x2a\x0e\xb5\xdf\xc5\x12\x70\x6c\x2d\x3a\xc8\x35\x39\x24\x67\x41\x1a\x64\x47\x02\x8b\xb2\xc6\xc2\x3b\xc0\xf1\x7c\xb1\x7f\xf0\xe2\x70\xff\xcd\xc9\x3b\x11\x5d\x49\x73\xbb\x92\x19\xc4\x71\x14\xe5\x9a\xac\x83\x4b\xdc\xcc\xe9\x8a\x4d\x8b\x3f\x17\xbf\x9f\xfe\xf6\xfe\xef\x8b\xb7\xf3\x7f\xe2\xe1\xed\x6b\x55\xe3\x37\x4e\x5c\xbc\x3e\xfd\x63\x1e\x7b\x9c\xef\xd6\xd6\x41\x23\x5d\x5e\x8d\x41\x89\x3e\x44\x51\x9e\x3a\x34\x92\xb3\x5b\x98\xc1\xc1\x33\xfe\x17\xf9\xba\x24\x83\x39\xf1\x9d\x81\xd0\x20\x71\x3c\x3f\x7e\x15\x45\xe5\x9a\xf2\xbe\x6a\x2c\x92\x14\x96\x5a\xd7\xf0\x5f\x34\x31\xe8\xd6\x86\x6e\x03\x7a\xe4\x11\xdd\xf8\x6a\x0c\xca\xe2\x83\xb4\xb6\xad\x8c\xb4\x08\xc1\xc1\x7a\x76\xdb\xc1\x5c\x1a\xdd\xc0\xe3\x31\xa0\x8c\x9d\x1f\xdf\x81\x08\xda\x64\x20\x2d\x48\xa8\xa5\x75\x60\xd0\x6a\xe3\x32\x1f\xce\xa1\x69\x14\xc9\x5a\x84\x72\x77\x13\x27\x29\x24\x41\x0f\x19\xa0\x31\xda\xa4\x5c\xbe\x2a\x99\x52\x38\x9a\x81\xb6\xe2\x0d\x3a\xa4\xab\x24\x74\x20\x7d\xe9\xdf\x78\x24\x7c\xb2\x47\x7a\x89\x9b\x0c\x48\xd5\xd1\xe4\xc6\xbb\xb7\xd2\x55\x77\xfc\xbb\x1e\xa5\x2f\xc3\xeb\x21\xc8\x72\x5d\xfa\xf4\xec\x11\x84\x2b\xce\x51\x16\x7c\x3e\xe1\xa3\x69\x34\xe1\xa0\x7c\xe2\xd1\x8c\xd3\x78\xaf\x3e\x77\x1c\x7b\xe7\x68\xc2\xb9\x27\x23\xf6\x8e\x66\xd0\x69\x5d\x7c\x34\xaa\x39\x57\xab\xca\x75\x68\x93\xe5\xba\x4c\x33\x88\x3f\x9b\xcf\x14\x77\xe1\x47\x9e\xb3\x6d\x69\xe3\x24\x65\xe3\xc4\x9c\x49\x2a\x93\x18\x9b\xd6\x6d\xc6\x2e\x8a\x60\xcf\xc6\x19\xf4\xf5\xde\x0c\xe4\x0c\xa7\x06\x8e\xfa\x57\x46\x37\xad\x1b\xf5\x23\xfe\x30\xea\xbe\x36\x41\xaa\x4b\xfd\xf5\x08\xe2\x94\xa5\xe3\x7b\x78\xc7\x2b\x18\x3a\xb4\xdf\x6c\xa9\x73\x9b\x2d\xc5\xda\x8a\xf7\x2d\x92\xa7\x37\x9e\x16\x78\x35\x75\x6e\x13\x67\xde\x7e\x71\xfe\xea\xaf\xf3\x0c\x9e\xa5\xd1\x37\x18\xbf\x87\x8b\x61\x1c\x6c\xef\x42\x06\x16\x1d\xec\x59\xd0\x06\xf6\xec\x11\xec\x5d\xc5\x59\x77\x89\xb3\xd1\x75\xf5\x25\xa5\x9e\x90\x02\x4b\x34\xe0\xdc\x46\x9c\xd4\x9a\x85\x19\x45\x13\xeb\x9c\x57\x21\x83\x4e\xa4\x59\x59\x10\x42\xf4\x20\xb9\xa0\xbc\x29\xf8\x3d\x4f\x36\x71\xa2\x9b\x46\x52\x91\xc4\x36\xa0\xe1\xf3\x42\x88\x34\x1c\x13\x0b\x57\x28\x82\x19\x67\xe8\x2c\xe7\x6b\x4a\x42\x6e\xf6\x48\xe2\x7d\xcc\x2b\x1d\xa7\x7d\x29\xc1\xd8\xd9\xa2\x09\x03\x7e\xdd\x1a\x45\x2e\xf1\x5c\x06\xca\xd3\x68\x52\x2b\xc2\x2d\xb5\x7e\xba\x8b\x33\xbc\x66\xfd\xa2\xe1\xa3\xa9\xd7\xf2\x22\xc8\xee\xe9\x67\x7a\x9a\x8e\x83\xd5\xe4\xcf\xfc\x80\x6d\x2f\xef\x9b\xe8\x87\xe2\x0e\xb5\x6c\x55\x7d\x9f\xa8\x1f\xa8\xe9\x38\xdd\x91\xea\x2d\x15\xf7\x62\x54\xa4\xba\xe1\x98\xa4\x41\x70\xdd\x08\x19\x8f\xcd\xbb\xb0\xfa\x8b\x60\x65\xed\x06\xfa\xfc\x26\xe3\x5e\xcd\xbb\xe5\x26\x5e\x61\xae\x0b\xec\xe8\xdb\x1d\xa8\xdf\x63\xed\x2e\x63\xdb\x24\xb7\x27\xe0\x03\xa2\xf8\xf1\xd6\xb9\x87\x8d\x2c\xde\xe2\x26\x09\xbb\x98\xdb\x9d\xed\xd0\x13\x30\xed\xac\x94\x0c\x7e\x3e\x78\x48\xa6\x65\xad\xf3\xcb\x6d\x2e\x89\x96\xa3\x77\xf4\x5e\xe2\xe6\x21\x21\x46\xbc\x87\x40\xb3\x7e\x6b\x9d\xe1\xf5\x9b\x93\x77\x89\xcf\x91\x46\x63\xbf\xb0\x94\xda\x5a\x2a\x5a\xa8\x7f\x77\xf7\x91\x65\x83\x2e\xfd\xb3\x8f\x0d\x9a\x72\x84\x02\xb7\x77\x5d\x5a\x30\x98\x6b\x53\x60\x01\x8a\x38\x12\x9f\x6d\x24\xa9\x12\xad\xeb\x16\xcf\x36\x78\x42\xb2\x41\xe8\x47\x94\xa2\x52\xf3\xe4\xe1\x89\x70\x4a\xa5\x4e\x41\x91\x3b\x7c\xde\x69\xe8\xd1\x78\xbb\x8e\xb0\xb2\x97\xf0\xc1\x82\x46\x79\x54\x5e\x64\xe0\xaf\x85\x91\xb4\x1a\xb2\x7b\x2f\xe6\x4c\x9c\x71\xda\xd9\x0c\x7c\xfa\x27\x4f\x00\xc5\xc7\x4d\xeb\x2d\x48\xce\xf8\x91\xb4\x33\xf2\xd1\x27\x08\xa3\x7c\xb8\x06\xfb\x3f\x75\x64\x75\xf8\xc3\x97\xce\xce\xfe\x66\xa0\xc3\xb7\x8f\x2e\x41\x52\x20\x2e\x83\xeb\x4a\xe5\x15\x28\x0b\x85\x74\x92\xa3\x28\x67\xb1\x2e\x61\x4d\x35\x5a\x7b\xeb\xe3\x6a\x8b\xbd\x63\x70\x9c\x71\x97\x44\x8e\x06\x9f\xbe\x2c\x37\x0e\x53\x48\xc2\xc3\xad\x85\x7e\x1f\x93\xec\xba\xb3\xba\xfb\x3d\x3c\xba\xd6\x2f\xef\x51\x1c\xa9\x7a\x98\x4d\xe4\x05\x3b\x68\x4f\x9c\xb1\x4c\xfa\x1e\xa9\x12\x6a\xa4\x84\xb3\xa5\xf0\x0b\xd0\x9d\x30\xe3\x41\xe4\xa3\xf8\xbd\xe1\xb4\x06\x5b\x69\xe3\xc0\xe9\x1e\x7f\x9c\xf9\x16\x86\xc6\x7b\xb2\x87\xfb\x32\x4a\xcf\x2b\x2e\xf1\xa1\x39\xe9\xa7\x23\xfa\xd2\x3d\xd1\xd1\x97\xac\x23\xcb\xb3\x98\x7e\xef\x52\x7d\xa7\xb6\x5c\x12\x69\xd7\x57\x95\xc1\xb5\xd1\xb4\xe2\xe5\xf6\xeb\x4e\x85\xfd\xf8\x0c\x85\x86\xc9\xf9\xff\x00\x81\x0b\x36\x1d\x1e\x0c\x00\x00")
+
+func crypt_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_crypt_go,
+		"crypt.go",
+	)
+}
+
+func crypt_go() (*asset, error) {
+	bytes, err := crypt_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "crypt.go", size: 3102, mode: os.FileMode(420), modTime: time.Unix(1792411181, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _debug_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x55\x61\x6f\xdb\x36\x10\xfd\x2c\xfd\x8a\x2b\x81\x06\x12\xa0\x31\x69\x30\xec\x83\x07\x0f\x70\x12\xb7\xcd\xe6\x25\x45\xe2\x2e\x1b\x86\x21\x60\xa4\x93\x4c\x84\x26\x35\x92\xb2\x13\x24\xfe\xef\xc3\x91\x92\xed\x24\xeb\xb0\x7e\x70\x09\xe6\xf1\xde\xdd\x7b\x77\xa7\x56\x94\xf7\xa2\x41\x58\x0a\xa9\xd3\x54\x2e\x5b\x63\x3d\x64\x69\xc2\x4a\xa3\x3d\x3e\x78\x96\x26\xac\x5e\x86\xff\xa4\xa1\x5f\x65\x9a\x43\xa7\x4c\x43\x67\xe3\xe8\xd7\x79\x5b\x1a\xbd\xea\x8f\x52\x37\x8e\xa5\x79\x9a\x1e\x1e\xc2\xf5\xd7\xeb\xcf\xe7\x27\x97\xbf\xdf\x9e\x4d\x4f\xbe\x7e\x02\xdf\x59\xed\xc0\x68\x70\xde\x76\xa5\xef\x2c\x56\xa0\x4c\xd3\x48\xdd\x80\xa9\x61\xbd\x10\x1e\xfc\x02\xc1\x76\xda\xcb\x25\x42\x65\xd0\x8d\x28\xce\x07\xc8\x8c\x85\x0a\xef\xba\x26\x87\xda\xd8\x80\xaa\xb0\x94\x4e\x1a\xed\x40\x7a\xf0\xe2\x1e\x5d\x01\xc7\x01\xe8\xad\x28\x31\x07\xa1\x9c\x09\x68\x5c\xa1\x7d\xa4\x38\xb5\x54\x48\xe8\x72\x81\xe5\xbd\xe3\x30\x33\x8d\x83\xc6\x80\x37\xe0\x7c\x85\xd6\x82\x70\x40\x55\x17\x40\x61\xcc\xab\x02\x6e\x3f\x9e\xcf\xa6\x20\x1c\x85\xfa\xf9\xfa\xf2\x02\x94\xd4\xe8\x78\x5a\x1a\xed\x82\x68\x21\xc3\xa9\x5e\x01\xfd\x1b\x03\x7b\xf9\x9c\xf5\x80\x8f\x52\x21\x81\xde\x00\x42\xfc\xa0\x5d\x8c\xa8\x70\x85\x6a\x4e\xc5\xc0\x18\x48\x73\x3e\xa3\x9b\x33\x0a\x02\xdf\xc1\xf7\x69\xba\x12\x36\x28\x88\x76\x40\x5c\xe0\x3a\x0b\x87\x33\xe9\x4a\x61\xab\xcf\x42\x57\x0a\x6d\x9e\xa6\x75\xa7\xcb\xa8\x61\x88\x92\x39\x88\x6e\xe5\x90\xed\x62\x17\x70\x67\x8c\x2a\x00\xad\x35\x36\x87\xa7\x34\x71\x6b\xe9\xcb\x45\x8f\x75\x7c\x6e\x66\x66\x8d\x36\x73\xe1\x8f\xa5\x70\x08\x8c\x15\xc0\x8e\xe8\xc7\xd4\x35\x1b\xa5\x49\x62\x91\xac\x86\xa3\x02\x6a\xa1\x1c\x16\xa0\xa5\x1a\xc0\x1f\x08\x18\xd2\xd8\x87\xbe\xaa\xae\x00\x6f\xbb\x97\xef\x8e\xe9\x5d\x70\x76\xff\xdd\x4e\xa2\x17\x4f\x36\x69\x22\x6b\xd0\xa1\x0e\x18\x8d\xa1\x6f\x51\x3e\xf1\x46\x66\x2e\xff\x31\xdc\x8f\xc7\x04\x86\x83\x03\xd0\xf0\x13\x1c\xc3\xd3\xff\x09\xfb\xa6\xb4\x7a\xe9\xf9\x94\xd4\xaa\x33\x26\xf5\x4a\x28\x59\xc1\x7b\x07\xef\xff\x66\x05\x0c\xfd\x50\x80\xcb\xd3\x4d\x18\x09\xa9\xa5\x8f\x06\x3a\xf4\x0e\xba\x76\xf0\xaf\xb6\x66\x19\xfa\x1a\xf5\x4a\x5a\xa3\x97\xa8\x3d\x87\x39\x8d\x43\xa0\xc4\x0a\x82\x83\xa5\x32\x0e\x43\x0b\x12\x58\x99\x26\x74\x75\x01\xb2\x06\xa1\x1f\x79\xb4\x79\xcb\x92\xe5\x90\xd1\x4d\x96\xef\x7b\xaa\xa2\xd5\x66\x27\xd0\x5e\x5f\x18\xc7\x3f\xa1\x47\xbd\xca\x86\xf4\xf3\x3c\xc8\x49\xd0\x77\x51\xb3\xe7\x67\x78\x67\xf4\xbe\x62\x91\x04\x9e\x36\x21\x64\x90\xca\xb4\xde\x51\xec\x83\x60\x6e\xdf\x88\x97\xad\xa7\x99\xa5\x97\x81\x6e\x14\xb5\x2e\xd2\x24\xb9\xc2\x56\x89\x12\x27\xde\xdb\x51\x8c\xd7\x58\xd3\xb5\x0e\xfe\xfc\x2b\x76\x5f\x01\x22\x36\x0a\x41\xf2\xdd\x31\xe4\x41\x19\x0a\xfe\x0b\x3e\x92\xaf\xbb\x76\xa2\x8b\x83\x03\x10\xfc\x37\xa1\x3a\xe4\x13\xfd\x98\xe5\x7c\xaf\xe1\x73\x82\xef\x0d\x5a\x08\x95\xf4\xf0\x61\xa8\xae\x03\x7d\xb8\xca\xd8\xfc\x6a\x72\x3a\x65\x39\xe1\x36\x69\xb2\x15\x40\xa4\x49\xb2\x29\xa8\xf0\x34\x69\x85\x5f\x50\xe5\xaf\xa4\xec\x07\x3f\xaa\x19\x30\xe3\x31\x30\x16\x38\xbf\x31\xc5\x17\xb8\x9e\xe3\x83\xef\xc5\x23\x6f\xae\xc3\xa2\x2a\x80\xd4\x25\x63\xfe\xc5\x80\xa1\x57\xeb\xad\xbd\xc6\xf1\xcb\x16\x35\x25\x90\x11\x71\x11\x6e\x6e\x6f\xae\x2e\x2f\x66\x7f\x3c\x87\xf3\xe4\xcb\x97\xe9\xc5\x59\x3c\x9f\x5e\x4d\x27\xf3\x69\x00\xd1\x93\x5f\x4d\x85\xd9\xd1\x0f\x47\x47\x6f\x1b\xe1\xbf\x3b\xe0\xdb\x45\xd1\xfa\x1c\x8a\x92\x86\xdf\x58\xe9\xd1\x66\x75\x3e\xd4\xc5\x6f\xa4\x5f\x64\xac\x95\x15\x2b\x7a\x19\x5b\x59\x65\x94\xc0\x2b\x3a\xa8\xf9\x29\x8d\x44\x96\x43\x5f\xfa\xa6\xdf\x75\x61\x59\x64\x4b\xd7\xc0\xb6\x7d\x6c\xe3\x80\x73\x2e\xb5\x47\x5b\x8b\x12\x9f\x36\x71\x1e\x42\x9e\x7c\x66\x9a\xac\xff\xec\xf1\x13\x51\xde\x53\xfb\xe9\x8a\x46\x67\x7f\x1f\x2c\x5d\x1f\x89\x73\x3e\x4c\xf5\x52\xba\xa5\xa0\x35\xa9\xe8\x8b\xb2\x5e\xc8\x72\x01\xc2\x7b\x2b\xef\x3a\x8f\xf4\x6d\x13\x1a\xf0\x81\x12\xf2\x58\xc5\xcf\x50\x25\xeb\x1a\xad\xdb\xce\x3d\x85\xb9\xeb\x48\x11\x10\xba\xea\x87\xde\xc5\x8d\x40\x83\x1b\x3f\x5a\x64\x87\x3b\xd7\xb5\x81\x5a\x48\xe5\x60\x2d\xfd\xa2\x9f\xf9\x21\x87\xde\x60\xa2\xdf\x16\x8e\x0f\x2d\x12\x75\x01\xa2\xf4\x9d\x50\xf0\x42\x81\x18\x7f\xa7\x43\xdc\x1c\xec\x15\xe1\x10\x9f\xf6\x30\x51\xb0\x02\x22\x13\x23\x2a\x16\x19\x0b\x60\x03\x17\xdb\xa7\x65\x91\x97\x0d\x09\xec\xf9\xb8\xb7\x3f\x03\x23\x48\x62\x8b\x39\xbd\x77\x23\x5a\xa6\xd2\xf5\x72\xa1\xf6\x5b\x5a\xa2\x23\xfd\xff\x19\x00\xaa\x03\x9f\x50\xc2\x08\x00\x00")
+
+func debug_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_debug_go,
+		"debug.go",
+	)
+}
+
+func debug_go() (*asset, error) {
+	bytes, err := debug_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "debug.go", size: 2242, mode: os.FileMode(420), modTime: time.Unix(1792409284, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _help_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x57\xdf\x6f\xd4\xc6\x13\x7f\x3e\xff\x15\x13\x4b\x81\xf3\x17\x7f\x7d\x88\xa7\x2a\x70\x7d\x28\x09\xa5\x15\xa5\xa8\xb4\xea\x43\x12\xa1\x3d\x7b\x7c\x5e\x9d\xbd\x6b\xed\x8e\xb9\x44\xc0\xff\x5e\xcd\xec\xfa\x62\x1f\x09\xa5\x48\x9c\xed\x9d\xfd\x7c\xe6\xf7\xec\xa6\x57\xe5\x4e\x6d\x11\x3a\xa5\x4d\x92\xe8\xae\xb7\x8e\x60\x99\x2c\xd2\xba\x55\xdb\x94\x9f\x1d\xf1\x43\xdb\xf0\xbb\xd2\x76\x20\xdd\xf2\x87\xf5\xfc\xdb\x2b\x6a\xc6\xe7\xaa\xd6\x2d\x8e\x0b\xde\x3a\x41\x12\xde\xd0\x8a\xd4\x66\xef\x34\xa1\x4b\x93\x2c\x49\x56\x2b\x68\xb0\xed\xcf\xb5\x83\xc6\xb6\x95\x07\x6a\x10\x5a\x6b\xb6\xe8\x44\x00\xb6\x06\x05\xa5\xed\x3a\x65\xaa\x5c\x96\x56\x2f\xca\xae\xfa\x31\x87\x7d\xa3\xcb\x86\x09\x52\x3f\xf8\x46\x6f\xec\x4d\x40\x88\x38\x85\xde\x69\x43\x1e\x54\x4d\xe8\x40\x93\x87\x0a\x7d\xe9\x74\x4f\xda\x9a\x22\x29\xad\xf1\x74\x50\xbd\x86\x94\x5f\xd3\x83\x3d\x2f\x83\x42\xd0\xc1\xa0\xbd\x75\x55\xd0\x07\xca\xef\x3c\xd4\x36\x5a\x37\x98\x16\x7d\xd8\xb3\x19\x4c\xd5\x22\x34\xca\x83\x62\x9a\x68\x33\x3b\x40\x8d\x22\x30\xaa\xc3\xa9\xde\x51\xc5\x4c\x77\x04\x5d\x18\x72\xb7\xe0\x90\x06\x67\x02\x7b\xa7\x8c\xae\xd1\x13\xa0\x88\x82\x2f\x1b\x6d\xb6\x50\x76\x55\x01\x6f\xb4\xd9\x79\xd8\x6b\x6a\xec\x40\x41\xff\xc4\xdd\x60\x03\x6a\x07\x76\x6f\x40\x39\x1c\xf1\x58\xc1\xe6\x56\xf8\x47\x6b\xa9\xc1\x5b\xe8\xad\x36\x04\x8a\x8a\xa4\x1e\x4c\x39\x33\x6a\x59\x76\x15\x78\x72\xda\x6c\x33\xf8\xdf\x68\x95\x88\xe0\x53\xb2\x60\x27\xe1\x6c\x0d\xe9\x46\x9b\x55\x0a\x4f\xd8\xba\x64\xc1\xe1\xaa\xb0\xa7\x86\x45\x4f\x9f\xc7\xf7\x17\xf0\x2c\xbe\x3e\x79\xc2\xd8\xc5\x47\xe5\xa0\xb6\x83\xa9\x8e\x88\x93\x85\x30\xe8\x1c\x84\xdb\x29\xb3\x9d\x04\x84\x91\x0b\x5d\x03\x16\x6f\x59\xf9\x7a\x2d\x91\x0e\xcb\x8b\x40\xb7\x86\x47\xe3\xf6\x4b\x7d\x2d\x82\x8d\x43\xb5\xe3\xb7\x2f\x49\xf8\xaf\xeb\xa8\x9b\x09\x74\x1b\xf0\x21\x03\xfc\x1d\x77\xf9\xbd\xa6\xb2\x11\x61\xa9\x3c\x06\x48\x71\x3e\x09\xf5\xc9\x1a\xd2\x14\x3e\x7f\x8e\xa2\x3f\x6f\x7b\xb1\x49\xd2\xf6\x4a\xb7\x78\x36\xe1\x95\x2d\x73\xaa\xd9\xfe\xf7\xb7\x5d\xab\xcd\x4e\x20\xe2\xd4\x1a\xb8\xa5\x8a\x5f\xad\x36\x4b\x0e\x71\x9a\x8f\x30\xe5\xb6\x48\xd9\x37\xb8\x5e\x2b\x57\x1d\x93\x4d\xb1\xc1\xc1\x2f\xc9\xd4\xe7\x2f\x52\x93\xd2\xad\x2f\x15\xa9\xd6\x6e\xa1\xd5\x9e\xa6\xf5\x5e\x8d\xd5\x11\xaa\x2f\x96\xd9\xa4\xf6\x7c\xce\x6b\xcc\x63\x0d\x4e\x4a\x14\xb6\xce\x0e\x3d\xd4\xda\xf9\xb1\xce\xa6\x8a\x96\x7b\xd0\xb6\xf8\x9b\x57\x5c\xc6\xf1\x1e\xd5\xe4\xf0\x81\x8b\x20\x6a\x8f\x4d\xe4\x97\x59\xb2\x10\x42\xcf\xc2\x4e\xed\x70\xd9\xa9\xfe\x32\x14\xea\xf5\xe5\x75\x78\xc9\x42\x29\x7e\xc8\xb9\x2c\xef\x4a\xe9\xe0\x01\xa7\x35\x98\xc5\x25\x9c\x86\xaa\x90\x9a\x3b\x6e\x81\xec\x39\x20\x9c\x4c\x2a\x25\xc0\xd6\x80\xc5\xcf\xfc\x16\xcb\x25\x98\x74\x29\x8f\x6b\x58\x83\xea\x7b\x34\xd5\x72\xb6\x2c\xc6\x64\x12\x7a\x4e\xcb\x9d\x03\xa3\xd5\x39\x3c\xcd\xa1\x45\x13\x61\x59\xf4\xe2\x60\x68\x70\x22\x08\xc5\x98\x40\x73\xd0\x26\x9f\x79\xd8\x10\xf4\xf0\x3c\x2e\xde\x0b\xb9\x0f\xe2\x2c\x49\x16\xb4\x67\xb6\xc3\x78\x2e\xde\xe2\x3e\xc4\x7f\xb9\x17\x13\x7e\xc8\xe1\x59\x0e\x8f\xe1\x71\x0e\x4f\xef\x22\x79\x64\x46\xd0\xcd\x56\x90\xa6\x56\x62\xb7\x8d\x01\xd1\x35\x84\xb5\xb5\xb4\x88\x84\x2d\x2e\x40\x3a\x26\x32\x8d\x91\xab\x3b\x2a\x5e\xc9\x08\xaf\x97\xb4\xcf\x21\x3d\xf5\x67\x57\x5c\xef\x82\xc8\x92\xc5\xbd\x99\x9c\xc7\x5b\x34\x4c\xc7\xe0\x98\xd5\xef\x4f\xeb\x0c\xce\xd9\x9d\xb4\xfa\x38\x3d\xbe\xb6\x15\xe0\xd4\x5f\xd1\xa9\x17\x83\xcb\xae\xca\xa7\x0d\x91\x3d\xe4\xe1\x95\x49\x43\x7a\x68\x5f\xbc\x6a\x07\xdf\x2c\x33\xee\x40\x69\x8d\xc1\xab\x2d\x2e\xa5\x11\x18\xf7\x2e\xc0\xd2\xbf\x78\xf9\x0c\x0e\xe7\xdf\xa5\x0d\x3d\x77\x3d\x7a\x06\xca\x6d\x7d\x51\x14\x81\x7b\x0a\x85\xf0\x6f\x7e\x74\x46\xd4\x95\x09\xfb\x67\x0d\x69\x7d\xf1\x9e\x2a\x3b\xd0\x11\xd1\xef\x41\xe5\x59\x54\xd1\xaa\x6d\x10\x9d\x63\xad\x86\x96\x7c\xf0\x62\xb5\x02\xed\x5f\xb3\x0e\xc2\xb6\xf5\xb0\x6f\x90\x1a\x74\x92\xbe\xf9\x91\xea\x94\x08\xa8\x51\x26\x56\xd3\xe1\xfc\x8f\x73\x22\x10\xcd\x4e\xa2\x8d\xb5\x92\x31\x5d\x0b\xe3\xc9\x7a\x76\xc4\x72\x2a\xc7\x91\xab\x5a\x8f\x12\xe5\x7f\x1f\x28\x63\x85\x3d\x30\x29\x58\x17\xac\xbf\xd6\x74\xa4\x6a\x3e\x56\xc9\x0d\x18\xe3\x21\xb9\x97\x90\xc8\x5b\x98\xaa\x47\xa7\xb6\x84\xc7\x54\x72\x7f\x91\xe8\xf0\xb5\x2a\x07\x5d\x8f\x43\x75\x72\xeb\xb0\x06\x63\x80\x0e\xcc\xd3\x29\x9a\xc3\x34\x60\xe8\x9c\x75\x31\x62\x27\xa5\xed\xfa\x16\x49\x6d\x5a\xe4\x33\xb4\xf8\x4d\x51\xd9\x84\xf9\x20\x5d\x31\x0b\x60\x47\xc5\x05\x83\xeb\x65\x3a\x98\x9d\xe1\x1b\xc5\x58\x6c\xa7\x3e\x9d\x4c\xb3\xfb\x3b\x4c\x34\x7e\xc8\xd9\x00\x96\x5b\x5f\xbc\xf1\xa4\x68\x39\xde\x17\xc3\xe1\xf6\x93\x36\xe7\x3a\x98\x9c\x49\x53\xc6\x53\xf9\xd1\x23\x01\x4e\x5a\xf4\x3f\x9b\x25\xbd\x7f\x72\x47\x77\xcf\xe9\xfd\xe9\xa8\x3d\xc3\xfc\x81\xff\xc3\xb4\xa5\x67\x40\xa6\x06\x6c\x3d\x3e\x80\x1d\x61\x62\x42\xb2\x90\x81\x79\xe4\xb1\xf2\x28\x2e\xc7\x3b\x69\x0e\xdf\x8a\x56\x9f\x3d\xe7\x8f\x5f\xfc\x5b\x4b\x17\x37\xda\xd3\x12\x9d\x9b\xe5\x49\xee\x2c\xd1\xdd\x00\x2e\x1b\x2c\x77\x17\x37\x58\xbe\x53\xd4\x08\xc3\xfd\xa1\x44\xe7\x8e\x90\x1f\xd1\xe9\xfa\x96\xa1\x7c\x83\xf9\x0e\xe8\x66\xa8\x0f\x36\x87\xbf\x12\x8a\x3f\x50\x55\x11\x7d\xa0\x7e\x98\xe0\x38\x82\x57\x46\x92\xb8\x19\xea\xec\xe8\x86\xf2\xcf\x00\x1f\xd9\xf6\x86\xb6\x0c\x00\x00")
+
+func help_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_help_go,
+		"help.go",
+	)
+}
+
+func help_go() (*asset, error) {
+	bytes, err := help_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "help.go", size: 3254, mode: os.FileMode(420), modTime: time.Unix(1792410513, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _hooks_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x55\x51\x6f\xdb\x36\x10\x7e\xb6\x7e\xc5\x55\x40\x06\x69\xd1\xe8\x6d\x2f\x03\x1c\xe4\xc1\x4d\x9d\x35\xc5\xd2\x14\x75\x8a\x15\xd8\x86\x80\xb1\x4e\x16\x17\x89\x14\x8e\x27\xc5\x41\x90\xff\x3e\x1c\x25\xdb\x52\x80\x6e\x7b\xb1\xe4\xe3\xdd\xc7\x8f\xdf\x7d\x47\x35\x7a\xf3\xa0\xb7\x08\xb5\x36\x36\x8a\x4c\xdd\x38\x62\x48\xa2\x59\x8c\x76\xe3\x72\x63\xb7\xf3\xbf\xbd\xb3\x71\x34\x8b\x8b\x9a\xe5\xe1\x7c\xff\x3b\xc7\x1d\x6e\xe4\xb5\xd1\x5c\xce\x0b\x53\xa1\xbc\x48\xc0\x33\x6d\x9c\xed\xe2\x28\x8d\xa2\xf9\x1c\xde\x3b\xf7\xe0\x41\x13\x82\x6b\xd8\x38\xab\x2b\x90\xd2\x96\xf5\x7d\x85\x1e\x8c\x05\x2e\x11\xee\x5b\x9b\x57\x08\xd4\x5a\xd0\xe4\x5a\x9b\x03\x76\x48\x4f\xb0\x71\x75\xad\x6d\xae\x04\x69\x09\x85\x36\x95\xb1\x5b\x68\x08\x7f\x10\x10\x28\x9d\x7b\x00\xcf\xae\xf1\x01\x65\xc8\x86\x82\x5c\x2d\x58\xd6\xd8\xad\x82\x25\x34\xce\xf3\xb1\x40\xb0\x2c\x62\xee\xc1\xb7\xbe\x34\xf7\x6e\x07\xec\xc0\xb5\x5c\x99\x0e\xc7\x38\x19\x78\x07\x86\xa1\xd6\x0f\x38\xdd\x80\x5a\x2b\x28\xbe\x6d\x90\x3a\xe3\x31\x57\xd1\xc6\x59\x1f\xa4\x93\x2d\x3e\x11\xae\x64\x3b\x38\x87\x58\xfe\xfb\xf9\x9e\x72\x3c\x24\x38\xcf\x21\xe3\x98\xb0\xe7\x18\x84\x9b\xa0\x5d\xf4\xbb\xae\x6c\x07\x10\x20\xd7\x5f\xd6\xef\xaf\xde\xde\x7c\xbd\xbb\xb8\xb9\xbe\x5e\x7e\x7c\x37\x80\x2e\x69\xeb\x87\xa4\x57\x79\xcb\xcf\xbf\xae\x87\xa4\xd5\xce\xf0\x9a\x35\xb7\x21\x75\x9c\xb4\xfa\x7a\x75\x7b\xb7\xbe\x5d\xde\x7e\x59\x07\x0e\x45\x6b\x7b\xc1\x3e\x69\x2e\x13\xab\x6b\x04\xcf\x64\xec\x36\x1d\x9e\xf0\x1c\xcd\x08\xb9\x25\x0b\x7b\x03\xa8\x0f\xce\xd8\xe4\xad\xf6\xf8\xce\x50\x76\x0c\x5f\x92\xab\xd7\x95\xf6\x3d\x4e\x9a\x46\x2f\x23\xfc\xd5\xce\x78\xf6\xd3\x1d\xee\x9d\xab\x04\xff\x2e\x03\x24\x82\xc5\x39\x38\xaf\x7e\xf3\xac\x39\x99\x50\x4a\xd3\x03\x07\xc9\x3b\x3f\x07\x6b\x2a\x41\x9f\xcf\xa5\x4b\xe2\x3e\x79\xf6\xed\x93\x4a\x90\xaa\x0c\x4c\x31\xf6\x5d\xa9\x3d\x18\xce\xe0\xd1\x70\x39\x69\xb4\xb6\xb9\x20\x19\x16\x0b\x6f\xdb\x1a\x2d\x7b\x48\xb4\x07\x0d\x1f\xd6\x37\x1f\x41\x13\xe9\xa7\x14\x8c\x0d\x29\x68\x3b\x43\xce\x4a\x96\x82\x2b\xf6\x62\xaa\xa6\x65\xd8\x3a\xf1\x8f\x0b\x96\xe1\x5c\x78\x7a\x07\x5c\x6a\x06\xc3\x90\xcb\xa2\x75\x0c\xb5\xd9\x1d\x09\x0c\x95\xae\x18\xd3\x51\xbd\x66\xc3\xb9\x92\xfe\x24\x9b\x3a\x1f\x54\xcb\x84\xa3\xcf\x84\x46\x07\x7f\xfc\xb5\x0f\xe2\x8e\x49\x83\x52\x6a\xaf\x2d\x12\x39\x12\x71\x4d\x01\x6f\x5e\x35\x20\x95\xf8\x5e\x51\x91\x72\xf6\x12\xcd\x1a\xd1\x7f\x2a\x7b\x28\x1e\x3a\xb3\x29\x71\xf3\x20\x6e\x0e\xcb\x4d\x7a\x16\x16\xde\x84\x56\x8c\xe1\x90\x28\xc0\x1d\x2b\x3b\x24\x53\x3c\x49\xe9\xa5\xa9\xf0\x7f\x94\x86\x6b\x09\xf3\x83\x29\xe4\x76\x52\xd7\x9a\x7c\xa9\xab\x44\x4e\x7f\x24\xf6\x6f\x20\x9d\x38\xdf\x23\xaf\x6c\x97\xc8\xbf\x0c\xa6\x73\x16\x54\x4d\xbf\x9d\x39\x4c\x5a\x36\x08\x9f\x0c\xb4\xd2\x63\x89\x6e\x1a\xb4\xf9\x50\x12\x3a\xa0\x94\x4a\xa3\x7e\x06\x85\xf9\x77\x32\xeb\xea\xa2\xce\x9f\x45\xb5\x05\x34\x19\x08\xea\xe2\xd0\xb8\xe7\xe6\x25\x83\x95\xed\x16\xd0\x83\xac\x39\x37\x76\x21\x63\x10\xde\x42\xc0\xb5\xbc\x8f\x20\x51\x08\x21\xd1\x28\x34\x51\x5b\xb6\x56\x9f\x5b\x9b\x7c\x53\xe6\xa2\x66\xb5\x12\x77\x14\x49\x7c\xe2\x17\x70\xd2\xc5\xd9\x30\x30\x48\x94\x06\xf5\x46\xde\xe8\xc7\x0c\x0f\x37\x0a\x18\x0f\x8f\x62\x6b\xfd\xea\xca\x95\x05\x76\x55\x0e\xfa\xde\xb5\x0c\xa5\x7b\x9c\x4c\x19\xda\x1c\xf7\xe6\x3e\xa2\x25\xc2\x31\x78\x55\x06\x8c\x07\xc3\x1e\xa7\x7c\x4c\xfc\xc7\x83\xb1\x76\x32\xc7\xbd\xc4\x48\xa4\x92\xef\x37\xa5\xa9\x72\xb9\xf5\xd2\x33\x89\x8f\x8a\x24\x57\x49\xe3\x92\xc9\xc9\x7e\xfa\xf9\x97\xc3\xf5\x44\xad\x95\xab\x3a\x8c\xdb\x7f\x0f\xda\x91\xef\x73\x34\xf3\xbd\x24\x83\xee\xd3\x6b\xf7\x14\xe2\xf3\x18\x4e\x61\xf8\x56\xaa\x2b\x76\x3a\x99\x9e\x3c\xed\x9d\x1c\x6a\xfb\xf6\xed\xc7\x7e\xfc\xfd\x08\x46\x1d\xd3\x11\x4b\x0a\x44\x7a\x76\x28\x1d\xb5\x59\xfa\x7b\xd9\x90\xb1\x5c\x24\x23\xdb\xc4\xbf\x6b\x92\x6f\xe5\x02\x4e\x4e\xbb\x3f\x6d\x9c\xed\x6b\x83\x2e\x2f\xd1\x3f\x03\x00\x76\x25\x24\xb4\x26\x08\x00\x00")
+
+func hooks_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_hooks_go,
+		"hooks.go",
+	)
+}
+
+func hooks_go() (*asset, error) {
+	bytes, err := hooks_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "hooks.go", size: 2086, mode: os.FileMode(420), modTime: time.Unix(1792409155, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _libs_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x6c\x53\x41\x6f\xa3\x3c\x10\x3d\xe3\x5f\x31\x1f\x27\x50\x28\xbd\x7f\x52\x0e\xad\xba\xab\xee\xaa\x87\xa8\xed\x65\x55\x55\x95\x81\x21\x8c\x02\x36\x1a\x1b\xba\x28\xca\x7f\x5f\x0d\x86\x34\xed\xee\x25\x8a\x9e\xdf\x7b\x7e\x9e\x79\xf4\xba\x3c\xe8\x3d\x42\xa7\xc9\x28\x45\x5d\x6f\xd9\x43\xa2\xa2\xd8\xba\x58\x45\x71\xaf\x7d\x73\x5d\x53\x8b\xf2\x47\x00\xe7\x99\xcc\xde\xc5\x2a\x55\xea\xfa\x1a\x5a\x2a\xee\x88\x81\x1c\x30\xf6\xad\x2e\xb1\x82\x62\x02\x37\xb8\x86\x3a\xed\x3c\x32\xbc\x93\x6f\xc0\x37\x08\x15\x31\x96\xde\xf2\x04\xb6\x86\x62\x30\x55\x8b\x15\xb8\x46\x33\x56\x8b\x13\x6b\x26\x74\xf0\xde\xa0\x99\x15\x81\x24\xe6\xc5\x40\xad\x0f\x56\x57\x01\xbd\x6a\xa9\x70\xb9\x1a\x35\xaf\x19\xb6\x10\xc7\x4a\x95\xd6\x38\xbf\x98\x4d\x3b\xed\x9b\x6f\x66\x94\xa3\x87\xbb\xb7\x87\x1f\xb7\x8f\x37\x8f\xbf\xde\x76\x37\xcf\xf7\xf1\x9c\xbe\x67\xec\xd1\x54\x2b\x8d\xd1\x0f\x6c\x1c\xa0\x19\xc7\x70\x59\x45\x0c\xfd\xe0\x81\x0c\xd4\x6c\x8d\x97\xe8\x92\xac\x25\x37\x83\x07\x9c\x72\x55\x0f\xa6\xfc\x62\x95\xcc\x16\x2f\xaf\x61\x5a\x99\xf0\xb2\xd9\x2c\x00\xe9\xf9\x08\x8e\x2a\xea\x19\x6b\xfa\x0d\xff\x6f\x85\x06\x1b\x88\xb7\xb1\x8a\x6a\xcb\x40\x19\x1c\x46\xc1\x59\x9b\x3d\x86\x58\x47\x15\x45\x54\x2f\x3e\x2e\xbf\xd7\x6e\x37\xcb\x93\xc3\x98\x41\x70\x4a\x67\x52\x34\xea\x76\x40\x51\xaf\xdc\x67\xa6\xee\x6f\xb2\x50\xa9\x86\xc0\xfe\x4f\x86\x18\xe4\x91\xc4\xdd\xac\xea\xc4\xba\x5c\xde\xf6\x40\xce\x3f\x61\xaf\x59\x7b\xcb\x29\x6c\x82\x4e\xf8\x27\xf9\xb1\x83\x97\x1b\x75\x2f\xb3\x48\xd6\x47\x1e\x4f\xd9\x1c\x3e\xcf\xf3\x74\x61\xbd\xd0\x2b\x6c\x97\x08\xb0\x91\xd1\xc8\x41\x58\x00\xd8\xc1\xab\xd9\xf0\xa4\x56\x68\x71\x14\x97\x35\xf9\xa6\x22\x4e\xd5\x49\x7d\x94\x67\x92\x25\x76\xfa\x80\xee\xa2\x3e\xd5\x45\xb3\x46\x72\x54\xb4\x08\xde\x86\x46\x4e\x46\x77\x54\x42\x6b\x75\x85\xbc\x2c\xf2\xc3\xea\xf3\x12\x3f\xef\x8c\xea\x73\xed\xce\x23\x5b\xa2\x8a\xea\x32\xfa\x3f\x9a\x91\x7d\x29\x68\x06\xeb\x27\x96\xff\xb4\x64\x92\x5b\xed\xf0\x8e\xf8\x02\xfe\xce\xb6\x7b\x6a\xb5\x6b\x92\x70\x6d\x9a\xca\xd3\xff\x0c\x00\xf5\x80\x87\x18\xbd\x03\x00\x00")
+
+func libs_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_libs_go,
+		"libs.go",
+	)
+}
+
+func libs_go() (*asset, error) {
+	bytes, err := libs_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "libs.go", size: 957, mode: os.FileMode(420), modTime: time.Unix(1792408544, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _man_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xac\x57\x5f\x73\xdb\xb8\x11\x7f\x16\x3f\xc5\x86\xd3\x4b\xc8\x84\xa6\x93\xf4\xe9\x9c\x28\x1d\xe7\x6c\x37\xee\xc4\x1e\xd7\xca\x4c\x67\x6a\xa9\x27\x88\x5c\x88\x38\x93\x00\x0b\x80\x92\x93\xc6\xdf\xbd\xb3\x00\x48\x51\x3e\x7b\x2e\x9d\xa9\x1f\x60\x08\xd8\x3f\x3f\xec\xfe\x76\x01\xb6\xac\xb8\x65\x6b\x84\x86\x09\x19\x45\xa2\x69\x95\xb6\x90\x44\x93\x78\xd5\x71\xa1\x62\x9a\x7c\xb5\x68\x68\x52\xa8\xa6\xd5\x68\xcc\xe1\xfa\x9b\x68\x69\x01\xb5\x56\xda\x6d\xf1\xc6\xd2\x3f\xaf\x20\xd4\xa1\x50\x9d\x15\x35\xfd\x68\x98\xad\xe8\xbf\x32\x7e\x3c\xc4\x3b\x2c\x68\xda\x32\x5b\x1d\x72\x51\x63\x1b\x24\x34\xae\xf1\xce\xd9\x35\x4a\x3b\x73\xc6\xea\x42\xc9\x4d\x98\x0a\xb9\x36\x71\x94\x46\xd1\xe1\x21\x34\x4c\x9e\x08\x0d\xc2\xc0\xb6\x42\x8d\xc0\x60\xd5\xc9\xb2\x46\xb8\x45\x6c\x0d\x08\x6b\x48\x04\x5a\xb6\x46\x93\x81\x90\x60\x2b\x84\xce\x74\xac\xa6\xf5\xf7\x06\x0b\x2b\x94\xfc\x40\xa6\x6a\xf6\x55\x75\x36\x8f\x0a\x25\x8d\xed\x0d\x4f\x21\x36\x15\xd3\x78\xd8\x30\x19\x47\xbb\xbd\x2b\x66\xab\x53\xb9\xa1\xfd\x8b\xe3\xcb\xab\xe3\x2f\x9f\xe2\x1e\x0f\x2d\x37\xec\x16\x8d\xf3\xe5\xe1\x94\x3b\x14\xb0\x11\x46\xac\x6a\x04\xab\x68\x31\x87\x63\x09\x9d\x34\x68\x21\x18\x22\x33\x6b\xb4\x06\x18\x58\xcd\x44\x2d\xe4\x1a\x0c\xb6\x4c\x33\xab\x34\x18\x05\xb6\x62\x0e\x03\x18\x2b\xea\x1a\x0c\x32\x5d\x54\xe8\x0f\x5b\x22\x67\x5d\x6d\x81\x62\x99\x47\xbc\x93\x45\x80\x94\xa0\xdc\x6c\xe0\x66\xe1\xe3\x97\x0e\x33\xf8\x4f\x34\x29\x85\x86\xa3\x29\xf4\x39\xc8\xff\xa6\x84\x4c\x3e\x32\x83\x27\x42\x67\xbb\xe5\x33\xad\x9a\x59\xcd\x4c\x95\xf8\xe0\xa4\x69\x34\x11\x1c\x84\xe4\x2a\x03\xd4\xce\x86\x32\xf9\xcc\x32\x9b\x94\x42\xa7\xef\xdc\xe2\xb3\x29\x48\x51\xc3\xf7\xef\xf0\x8c\x24\xf3\x73\x73\x22\x74\x92\x92\xdf\x89\x46\xdb\x69\x09\x04\x2d\x9a\xdc\x47\x13\xae\x34\xfc\x9a\xc1\xed\x86\x4c\x69\x26\xd7\xe8\xf6\x9c\xac\xe0\x10\x72\x9f\x7f\x62\xe6\x4a\x23\x17\x77\xc9\xed\x26\x1b\x65\xe3\x55\x3c\x8d\x53\x78\xfe\x9c\x0c\x3c\x9b\x3e\xd8\x70\x46\x7a\x8f\xad\xc6\x16\x65\x19\xb6\x5d\x6c\xc6\x86\x32\x20\xfc\xd1\x84\x30\xdd\x47\xbd\x92\x41\xfb\xa4\xf0\x2b\x8f\x2d\x51\x26\xa7\xe5\xcf\xc2\xd8\x59\x9f\xb3\x34\x8d\xee\x1d\x39\xb8\x90\xe5\x05\xe9\xad\x11\xbc\xcd\x7d\x8e\x10\x3f\x40\x71\x28\x9a\x32\x23\x88\x1c\xb5\x4b\x11\xc9\xd4\x6a\x8b\xc6\x92\x95\xc0\xd9\x90\xdd\x91\xcd\xa4\x68\x4a\xe8\xf3\x9b\xf8\x89\x4b\x8c\xd2\x2e\xdc\x82\xc3\x33\x2a\xdd\x1a\x2d\x5b\xd5\x78\xc9\x1a\xcc\x2f\x98\x2d\xaa\x99\xc7\x5e\x34\xe5\x5e\x5a\xe2\x38\x03\xde\xd8\xfc\x94\x2c\xf0\x24\x16\x72\xc3\x6a\x51\x42\xa1\x9a\x86\xc9\x12\x7e\x32\x71\x46\x58\x53\x17\x24\x07\x9e\xd2\xe6\xaa\x37\xbf\xe8\x8c\xfd\x45\x35\xad\xa8\x31\x59\xfe\x6b\x09\xaf\xfa\x8d\xbf\x77\xca\xe2\x05\x5a\xe6\xfd\xbd\x82\xe5\x3c\xbf\x79\x7d\xf0\xf3\xe2\x86\x1d\x7c\x5b\xbc\x4c\xe6\xf9\xfa\x5b\xfa\x97\x3f\x2d\xd3\x68\x12\x4e\x6a\x32\xf8\x75\x8f\x9f\x7f\xad\xd5\x2a\xf9\x9f\xd9\x9a\x41\xdc\x30\xf9\x32\x26\xda\x52\x63\xc9\x67\xb5\x28\x30\xd9\x39\xa1\x78\x26\x22\x83\xdf\x40\x48\x9b\xc2\x4a\xa9\xda\x85\x83\x65\xb0\x22\xff\x0d\x93\x33\x2f\x3c\x28\xdd\x88\x45\x9a\x3d\xba\xf1\xdb\x22\xf5\xa4\x65\x44\xc5\xd5\x1e\xfb\x18\xbc\x87\x95\x67\xd7\x8e\x5b\x83\x41\x78\x0f\x23\x2b\xd1\xe4\x3e\x1d\x0a\x23\xac\xef\xaa\xa3\x17\x74\xe6\x6b\x61\xec\x50\x88\xbe\xf1\xe6\xd7\xc8\x4a\xaa\xb7\x20\x18\x30\x8d\xea\xd2\xe1\x2a\x94\xb4\x42\x76\x18\x30\x05\x6f\x5c\xec\x1c\x91\x6d\x2f\x2b\xb8\xe3\xe9\x1e\x73\xb8\xc8\x89\x4d\x49\xea\xf9\x33\x9c\x6a\x3f\x47\x01\x43\x06\x3b\xf1\x8c\x30\x44\x13\xef\x76\xaf\xd8\x1e\x92\x4f\xaa\xa1\x85\x02\xe1\xdb\x91\xef\xbe\x6f\xbc\x21\x07\x7b\xa5\x25\xbb\x66\x85\x9a\x8a\x8a\xed\x35\x7c\xaa\x59\x2c\xac\xd2\x5f\xb3\xbe\xa5\x06\x2b\x6f\x5e\x03\xb1\xc3\x00\xe3\x16\x35\xad\xbc\xcd\xe1\x24\x48\x0b\x34\xb0\x15\xb6\x52\x9d\x05\x25\xd1\x49\x42\xcd\x8c\xdd\xf5\xda\x9e\x09\xd4\x54\xfb\x62\x14\xd2\xfa\x3e\xbb\x16\xd6\x50\x50\xfb\x4e\xf6\x45\x8b\x26\xb4\xb2\x21\x56\x44\xe5\xa4\x1c\x08\x1b\x87\x2e\x3b\x56\x3b\x97\x25\xde\x9d\x11\x5d\xbd\xc9\xc0\x5d\x0d\xba\x93\xd8\x33\x37\xc4\x01\x34\xbc\x87\x17\xaf\x5f\x50\x07\xd6\xf0\x01\x5e\xfc\xfc\x02\xee\xd3\x77\x20\xe0\xc3\x14\x5e\xbb\x7c\x05\x5c\x53\xf0\x93\x9b\x23\xb1\x70\xa9\x90\x03\x9b\xc2\x05\x9c\x1f\x5b\x25\x82\x4f\x0f\xeb\x01\x93\x82\x4b\xba\xeb\xf3\x0b\x76\x77\x2e\xed\x9f\xdf\x8e\xb3\x2a\x77\xd9\x22\xf8\x60\x2a\xb5\x35\xc0\x7c\x5a\x29\xb0\x2e\x69\x94\x68\xc5\xdd\xb4\x52\xc4\xe9\x6d\x25\x8a\x2a\x5c\x9f\xc2\x82\xb0\x06\x6b\x9e\x47\x1b\xa6\x07\x4b\x53\x1f\x82\x76\x88\xb9\xeb\x7b\x04\xaa\x61\xbb\x73\xd0\x9b\x23\xff\xac\xd4\x2d\x35\xe9\x64\x14\xde\xc7\xcf\x81\x5a\x3b\xf4\xd4\x58\x7b\xed\x5f\x7c\xf7\x4b\x9c\xd9\x36\x75\x9b\xf9\xcc\x96\x42\x66\x10\xa6\xaa\xb3\xc3\x9c\x0c\x87\x6b\xd1\x89\x28\x33\x48\xf8\xa9\xf3\x11\xfc\x91\xce\x75\x27\x93\x9e\xd4\x14\x9e\xfe\xc2\xf0\xa1\xa2\x98\x8c\xae\x09\xb0\x95\x56\xdd\xba\x02\x87\x46\x69\x60\x06\xda\x9a\x09\x09\x16\xef\x88\xa2\xb0\x25\x3b\xdb\x0a\xe5\x10\x4e\xa8\x98\x01\x5f\x4e\x81\xb5\x23\x37\xc9\x16\x84\xca\xff\xa1\x85\x45\xed\xce\xf0\xfb\x78\xb6\x43\x34\x1f\xdc\x3d\x3f\x12\xc9\x20\x70\x34\x85\xa2\xc2\xe2\xf6\xf4\x0e\x0b\x97\x8a\x36\x7d\xf7\xc3\x9a\x1b\xd4\x82\x7f\x25\xd5\x33\xba\x5d\xfe\x58\xd5\xe7\x20\x30\x25\x69\x3d\xce\x67\xfe\xd1\x9a\x9f\x9b\x04\xb5\xce\x7c\x72\x4f\xb5\xbe\x54\xf6\x4c\x75\xb2\x4c\x1f\xb3\x54\xab\xf5\x1a\x75\x7e\x82\xab\x6e\xed\xe8\x03\x52\x59\xe0\x24\x9f\x01\x67\xb5\x7b\xac\xad\x58\x71\x0b\x56\x8d\x12\x11\x67\x10\x53\xd6\x62\xcf\x98\x60\x74\x4b\x51\xbe\x22\xa1\x21\xf8\x6e\xff\x3e\x72\xcc\x4e\xa2\x89\x56\x9c\x9f\x29\x69\x01\xe0\xf1\x8b\x75\x3e\xe7\xc9\xfc\xe6\xe6\xf8\xe0\x9f\x8b\x97\xf3\xc5\xf7\x79\x92\xe7\xdf\xf3\x74\x99\x7a\xd5\x53\x53\xb0\x16\x61\xd7\x37\x2e\x71\x7b\x8d\x6d\xcd\x0a\xd4\xc9\x72\x7e\xb0\xcc\x20\x3e\x88\x33\x58\xce\x13\x6c\xdc\x8f\xfe\x97\xdc\x6d\xe1\x92\x46\x37\x3c\xa7\x55\xb7\x08\x34\x83\x38\x0d\xaf\xf0\xdf\x9d\xc4\xaf\x78\xba\x7a\x26\x72\x60\x40\x98\x76\xa5\x4e\x3d\x94\x2b\xdd\x30\x6b\x85\x5c\x1f\x91\x1d\x8d\xff\xee\xd0\x50\xef\xd5\x08\xa5\x56\x6d\x8b\x25\xe0\x5d\x81\xad\x13\x85\x0a\x59\x49\x07\x01\x7a\x7e\x8c\x6d\x73\x0a\x52\xc3\x0a\xad\x4c\xe0\xf4\x23\xc1\x1d\x33\xfb\x91\x3e\xb1\xea\xf8\x63\xb7\x67\xe0\xd8\x8f\x71\x7b\xf4\x42\x9d\x75\x9c\xda\x7a\x9b\x41\x9c\xaf\xbf\xc5\x81\x4e\x83\x07\xfa\x74\xf2\xf9\x60\x25\xea\xc4\x7d\x5b\x8d\x7f\x77\x3c\x7d\xe2\xb2\x1e\x7b\x75\xf7\xa6\xe0\x30\x60\xdf\x83\x7e\x5c\xd7\x89\x4e\xdf\xfd\xa1\x85\xfb\x28\x9a\x98\x82\x49\x89\x0e\x9a\xfb\xe0\x23\x2c\x33\xbf\xf6\x14\x38\xca\x48\x50\xcb\x49\x34\x3c\xea\x6b\x21\xdd\x43\xb0\xdf\xfa\x82\x77\x36\x49\x9f\x7a\xc1\x93\x34\x85\x28\x4e\xe9\x82\x7a\x72\xff\x45\x08\xe0\x84\x0b\xac\xcb\xbd\x3b\x74\xd6\xd6\xc2\x5e\x3a\xc1\x9b\x37\x47\x0b\x47\xcc\x0c\xde\xa6\xfe\xa4\xc6\x92\x6c\x1c\x87\xa7\x4b\x8d\x32\xf1\x26\x52\x98\x4e\xe1\xed\xf0\x5e\x31\x76\x54\x27\xa1\x48\x92\xf1\x35\x3d\x6b\x69\xc5\xeb\xde\xbc\xa1\x67\xdf\x32\x0e\xf5\x70\xf0\x26\xed\xdf\x30\x13\xb3\x15\xb6\xa8\xe0\x49\xcd\xd7\x8b\x70\x90\x82\x19\x84\x78\xf6\x29\x3e\x72\x08\xe8\xad\x73\xd6\x6a\x21\x2d\xa7\x36\x10\xcf\xe5\x4f\x66\x2e\xe3\x0c\x08\x5a\x3a\x52\x98\x3d\xa9\x00\xf0\xb8\xca\x47\x6a\x3f\xe7\x34\x7c\xbc\x76\xa3\x9b\x9f\xbb\xf9\xb9\xdb\xbc\xf6\xe3\xf9\x13\xa6\xc1\xff\xf5\xd6\x87\xce\x32\xc4\xa9\xef\x53\xfd\xc2\x71\x5d\x87\x97\x21\x41\xa1\x20\xa5\xe9\x08\xd0\xd5\x15\xb9\x73\xc3\x67\x37\x7e\x71\xe3\xb9\x1b\x4d\x4b\xe3\x4a\x3f\x79\xcc\x78\x17\xec\x87\xef\xd6\xff\x1f\xee\x40\x3b\x8f\x7b\xf4\x09\x18\x38\x7d\xaa\xb5\xbb\xa4\xff\x3b\x00\x30\x72\x32\x4e\x35\x11\x00\x00")
+
+func man_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_man_go,
+		"man.go",
+	)
+}
+
+func man_go() (*asset, error) {
+	bytes, err := man_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "man.go", size: 4405, mode: os.FileMode(420), modTime: time.Unix(1792411825, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _manifest_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x9c\x58\xeb\x6f\xdb\xc8\x11\xff\x2c\xfe\x15\x13\x02\x29\xc8\x1e\x4d\xf9\x80\x43\x0b\xd8\x50\x80\xb4\xce\x35\x39\xe4\x85\xf8\x8a\x7e\x30\x8c\xdc\x8a\x3b\x14\xb7\x22\x77\x89\xdd\x65\x1c\x9d\x4f\xff\x7b\x31\xfb\xa0\x48\x59\xee\xc5\xc9\x87\xd0\xe4\xce\x7b\x7e\xf3\x58\xf5\xac\xda\xb2\x0d\x42\xc7\x84\x4c\x12\xd1\xf5\x4a\x5b\xc8\x92\x45\x8a\xb2\x52\x5c\xc8\xcd\xf2\xbf\x46\xc9\x34\x59\xa4\x75\x67\xe9\xa1\x0c\xfd\xdf\x33\xdb\xc4\xe7\xb2\x16\x2d\xc6\x0f\xc6\x6a\x21\x37\x8e\xc6\xec\x4c\xc5\xda\x36\x4d\xf2\x24\x59\x2e\xa1\x63\x52\xd4\x68\xec\x2f\xd7\x1f\xde\x83\x30\xa0\xb1\x6f\x59\x85\x1c\xd6\x3b\x30\x83\x69\x44\xc7\x8c\x45\x0d\x77\xc2\x36\x60\x1b\x04\x94\x56\x0b\x34\xa0\x6a\xf7\x2a\x64\x3f\x58\xb0\x1a\xb1\x4c\xbe\x30\x3d\x97\xb7\x82\x34\x4d\x66\x9f\xe1\xe6\x36\xfe\xf9\x4a\x5a\xbd\x4b\x12\xbb\xeb\x11\x66\xdf\xc0\x58\x3d\x54\x16\xee\x93\xc5\x7b\xd6\x21\x00\x78\xf3\xc1\xfd\xfb\x8d\x3c\xbf\x48\x25\xeb\x30\xfd\x2d\x59\xfc\xba\xeb\x1f\xa1\x20\xc1\x44\xf1\x4e\x71\xa2\x50\xa6\xfc\x59\xb4\xe8\xde\x02\x45\xa7\xb8\x97\xc1\xf4\x06\xed\x49\x19\xee\xa4\x50\x9d\xb0\xd8\xf5\x76\x47\xd4\xd7\xe2\x77\x92\x27\xa4\xfd\xdb\x4f\x30\xa3\x36\xe2\x77\x9c\xd3\xbe\x66\xa6\x39\x6d\x5d\xc3\x4c\x33\xa3\x4d\x16\x6f\xa4\x45\xdd\x6b\xa4\x70\xc3\x94\x2b\xb0\x88\xc3\xf9\x5c\xcb\x84\xf1\xa5\xde\x18\xb8\xb9\x0d\xac\x81\x91\xe9\x8d\x39\xd2\x75\x85\xa6\xd2\xa2\xb7\x42\x49\x98\x13\xf3\xc3\xc9\x5c\xcb\xbf\xb4\x1a\xfa\xe0\xf0\x9c\x65\x43\x27\x33\xe2\x7d\x92\x54\x4a\x1a\x87\x59\x02\xcc\x8e\x42\xef\x38\x57\x90\x12\x30\xd3\xf0\xfd\x4a\x68\x88\xdf\xb9\xd0\xf1\xf3\xf5\xae\x6b\x85\xdc\xba\xcf\xc6\xff\x1d\x8f\x5e\x33\xcd\xdd\xd9\x0a\xd2\x26\xfc\xed\xc0\x5c\x0f\xb2\x82\x56\x31\xfe\x2e\x80\x29\xcb\x01\xb5\x56\x9a\x80\x34\xe2\x6f\x05\x52\xb4\xc9\x42\xd4\x47\x48\x25\xa8\x12\xe1\x42\xa3\x1d\xb4\xf4\x54\xfb\x24\xbe\x92\xa3\xe5\xbf\x65\xc7\xb4\x69\x58\x9b\xdd\xdc\xae\x77\x16\xb3\xa9\x88\xbc\x80\xbf\xc4\xf7\x9c\x02\xb0\x5c\x82\x46\x63\x95\xc6\x57\xa1\x64\x34\x56\x1a\x99\x45\xe3\x2a\x87\x0b\x8d\x95\x55\x74\x52\x40\x70\xd2\x00\x93\x1c\xa2\x5b\xc6\xcb\xa8\x94\xe6\xc8\x41\x48\xc7\x36\x7a\x32\x48\x8e\x9a\xa4\x94\xf0\x09\x37\x43\xcb\x34\x50\x64\x0d\x74\x83\xb1\xc0\x5a\x8d\x8c\xef\x60\x8d\x13\x43\x38\x18\x05\xb6\x61\xf6\xa0\x02\x1a\xf6\x05\xc1\xa8\x0e\x6d\x43\x29\xb5\x0a\x7a\x25\xa4\x05\x66\x4b\x1f\xd2\xb9\x13\x19\x17\x3a\xa4\x7f\x12\x5e\x2f\xea\x62\x15\xfd\xf0\x15\x65\xb2\x43\x40\x16\xb5\xd2\xf0\xb9\x00\x24\x2a\xcd\xe4\x66\xe2\x09\x85\xbd\x2f\x48\x1a\x1d\x32\x63\xd0\x7e\x64\xb6\x21\x55\x05\x60\x49\x4d\x20\x4f\x16\x94\x33\x22\x79\xe6\x52\xe8\x98\x62\x76\x50\xeb\x64\x41\xd9\x5a\x98\x3b\x61\xab\x06\xb0\x74\x7d\x81\x68\x2a\x66\x10\x46\x0c\x5e\x24\x8b\x51\xd0\xc5\x8a\x9a\xc2\x3f\x9b\x4e\xf1\xac\x2f\xa2\x9f\xd4\x20\x32\x2c\xe9\x51\x7e\x44\xdd\x65\x79\x9e\x5f\x3e\x50\x3c\xd7\xbc\xd8\xcf\x14\x5d\x09\xfd\x50\xcf\xbb\x2d\x17\xfa\x65\xdb\x92\xaa\x49\x2f\xca\xce\xff\x7e\x7e\xfe\x64\x0d\xa1\x3e\xa2\x96\x67\xf4\xf2\x1f\x41\x19\xfc\xa4\x94\xcd\xe8\xd5\xc4\xd0\xd1\xd3\xe7\x23\x9f\x4b\xae\x3b\x5b\xbe\xa2\x0c\xd6\x59\x2c\x32\x78\x6e\xe0\xec\x05\xfd\x8f\xa6\x62\x7d\x40\xea\x7a\x90\xbc\xc5\xf4\x84\xc0\x68\xd9\xdc\xd5\x60\x5d\x16\x27\x50\xf9\xb3\x56\xdd\x75\xcb\x4c\x93\x8d\x9c\x05\xf4\x4f\x75\x3a\x56\xbe\xf3\x3a\x74\xe6\x47\x41\x33\x31\x50\xd4\xdf\xa2\x67\xee\xc2\x5b\xb2\x3f\xea\xf8\x46\x4b\x39\xd6\x6c\x68\xed\x45\x72\x3a\xc4\x83\xdc\x4a\x75\x27\x0f\xb0\x77\x4e\x81\x9b\x7f\xcf\xcd\x05\x3c\x37\x69\x11\x80\x3b\x45\xfd\x9e\xda\x50\xb2\x58\x2e\xe1\x2a\x34\x8c\x1d\xd0\xe0\x32\xc0\x34\x02\xeb\xfb\x56\x20\x87\x96\x19\x5b\x00\x47\xec\x49\x72\x2d\x34\xbd\xc6\x62\xa7\x4e\x70\xa6\x64\xbb\x73\x62\x26\x7d\x07\xb8\x02\xa9\x2c\xac\x5b\x55\x6d\x43\x01\xb8\x26\xd0\xa0\xd0\x50\x35\xa2\xe5\x1a\x65\xe9\x8b\x57\x50\x68\x5a\x94\x87\xa2\x86\x33\xf8\xf1\x12\x04\xbc\x58\xc1\xf9\x25\x88\xb3\x33\x17\x1a\x57\xe1\x91\xe6\x46\xdc\x86\xca\xf5\x15\xf9\x6c\x35\x96\x88\x8f\x63\xa5\xa4\x15\x72\xc0\x50\xbf\x27\x4a\x73\x44\xd1\x2f\x4a\x48\x9f\xde\x93\xc0\x72\x01\xcb\x9f\x58\xc7\xc7\x0d\x64\x9f\x4c\x07\xc0\x3e\x4c\x95\xaa\xc1\x6a\x1b\x1a\xe0\x1b\x59\xab\xe9\x64\xf9\x8e\xbe\xf6\x0f\x66\xf0\xea\xe9\xbd\x8d\xbc\x26\xed\xc5\x14\xa7\xc6\x32\x9b\xf5\xdf\x26\xe2\x4f\xfa\xa3\x37\xe9\xb8\xbd\x10\xd6\x48\x99\x0f\x65\x2c\x14\xcf\xbf\x3a\xce\xa6\xa7\x5e\xcd\x72\x40\x9f\x66\x8d\x22\xfa\xe1\x04\x66\x39\x59\xec\xd8\x66\x75\xd5\x09\xd3\x31\x5b\x35\xd4\x2e\xfd\xa2\x56\x38\xaa\xe2\x98\x7d\x26\xfa\xc8\xae\xe0\x47\x90\x7c\xd4\x32\x94\x29\x3f\x21\x73\x1d\xc5\x07\xf0\x74\xa7\x38\x2a\xf4\xc5\x3e\x52\x8e\x20\xfc\x55\x79\x08\xda\xd0\x64\x9f\xad\xc6\xfe\x73\x24\x63\xe6\x94\xb3\xcc\xf3\xa4\x87\x8e\x55\x3c\x2a\x37\x3f\xa8\xff\x9e\xbe\x38\x01\xdd\xd3\x7b\xa3\x97\x7a\x1a\x7c\xf6\x7b\x3a\xed\x33\x1a\x13\xac\x43\x1a\x83\xd9\x01\xd8\x07\x3d\xf9\xff\x81\xc3\xb8\xf6\x4d\xc3\x26\xa4\xe2\xf8\xa1\x1e\x85\x1d\x80\x71\xaa\xac\x97\xcb\xc8\x00\x7e\xe1\x5d\xa3\x81\xbb\x46\x54\x8d\x8b\x3f\x08\x59\x2b\x10\xa6\x00\x2a\x6f\x36\xae\x4b\x81\xc4\x6d\x48\x6e\x3b\xc3\xd6\xe0\x5d\x83\x1a\xa9\xd3\x4a\xc0\xaf\x3d\x56\x16\x79\x58\x9d\xa2\x4d\x4e\x5a\x18\xfa\xde\xb9\xb0\x40\xdf\xbb\x4d\x94\x7a\xb5\xda\x52\x50\x89\xb0\xbc\xde\x99\x2c\x2f\xb3\xbf\x86\x9b\x5a\x79\x6d\x99\xfd\x6c\xf3\x4b\xa2\xb9\x4f\x66\xb3\xe5\xba\xd7\x42\xda\x3a\x4b\x9d\x26\x78\xce\xfd\x3d\xed\x39\x07\xb7\x02\xa4\x05\x18\x5b\xbe\x91\xca\x3d\xdf\xd3\xb7\x7c\x1a\x0c\xa7\x8e\x5a\x50\x16\x37\xd6\x8e\x7d\xa5\xd1\xf7\x5a\xf5\x06\xd6\x6a\x90\xdc\x40\xa3\xee\xa8\xa5\xed\x26\x3b\x6a\xc8\x13\x74\x6c\x07\x1b\x1a\x32\x5a\x0d\x9b\xa6\x00\xe6\xf6\x05\x92\xb3\x45\x2d\xb1\x05\xae\xd0\xc0\x1a\x6b\xa5\x11\x36\xe2\x0b\xb9\x3c\xf4\xde\xc6\x57\x6f\x3f\x7c\xf8\x58\x86\x6b\xc2\x54\xed\x0a\x7e\x3a\x77\xb6\xcc\x77\x49\xe8\x58\xef\xc4\x03\x5d\xfd\x40\xd5\x80\x5f\x50\x8f\x56\xc5\x69\xaa\x40\x58\x13\xec\x0b\x59\x38\xda\x49\xe3\x05\xf6\xe8\x26\x9a\x93\x82\x1b\x9f\x97\xdb\x43\x7a\xc6\xcd\xb6\x63\x5b\xcc\x1e\x90\x9c\x5c\x6c\xa3\x86\xfb\xe4\x4f\x9b\x92\x13\x7f\xe3\xe7\xc0\x2d\x1c\x1a\xc7\x31\x6c\x1d\x5d\xc8\xd1\x7c\xd9\x03\x8d\xf4\xeb\x00\xa1\x17\x6d\x83\x1a\x18\x68\x6c\x99\x15\xb4\xd6\x07\x55\xb1\x29\x68\x34\xaa\xfd\x82\x9c\xa4\xd4\x5a\x75\xb3\x7b\xc8\x0e\x1a\xd5\xd2\x6f\x0b\x2e\xc0\x84\x18\xb6\x33\x20\xa4\x11\x1c\x27\x6b\x20\x68\xa5\x6c\x09\x6f\xc5\xd6\x25\x9a\x0e\x7c\xb2\x0b\x10\x16\x6a\xd5\xb6\xea\xce\xe7\x69\xc4\x8b\x08\xf6\x43\x87\x16\x94\xbf\xc6\xdc\xb1\xdd\x61\x41\x61\x24\x29\x2d\xcb\x14\x58\x6d\x51\x83\x92\x18\x7e\x5f\xe8\x40\x50\x36\xb7\x28\xbd\xc1\x63\xad\x59\x27\x12\x5a\x64\xdc\x84\x3c\x9f\x5a\x82\x1f\x26\xb5\x08\xee\xd9\xe9\xa5\x3f\x87\xb5\x52\xae\x63\x35\x04\xc1\x8b\x15\x9c\x27\x0b\xfa\xdd\xe2\x8e\xb5\x5b\x20\xe9\xee\xde\x73\x33\xca\x38\xe2\xce\x0e\x27\x24\x28\x4f\x16\x8e\x71\xf5\x1d\xac\x11\x35\x81\xcc\x5f\x4c\xff\xf8\x03\xdc\x48\x78\x63\x5e\xae\xcd\x38\x68\xa6\x33\x5e\x8a\xb6\x80\x9a\xb5\x26\x6e\x52\xd5\xe0\x07\x40\xdf\xa3\xe4\xa3\x92\xfb\x7d\xe1\xae\x8c\x65\x49\xed\x31\x40\xb7\x3a\x40\xd7\x53\x99\xf2\xba\x6f\x85\x1d\x17\xe0\x74\x99\x06\x6d\x61\x7f\xa8\xfc\x9b\x9b\x41\x69\x5a\x40\x5a\xa6\x6e\xfa\x4c\xd7\xb9\x70\x5a\xc6\x23\x51\xbb\xed\xb1\x1a\x74\x4e\x5e\x9d\x1f\xcd\xc6\x99\x03\x71\xcc\x92\x13\x2b\xa8\x06\x7d\x73\x11\x79\xcf\x7e\xbc\x7d\xa0\x69\x9f\x8c\xb4\xc1\xdf\x6a\xd0\x05\x54\x6e\x04\x48\xfc\x3a\xf6\x57\x5f\x6e\xd1\x49\xb7\x50\x3a\x4a\x72\xf0\x76\x1c\x4d\x71\x61\x78\xa0\x42\xd4\x40\xf8\xf8\xe1\x87\x4b\xf7\x84\x17\xb3\xc6\x75\x9f\x3c\xea\x4e\xe4\x76\xca\x14\x01\x83\xf0\x91\x1d\x3b\x56\x00\x19\x9b\x5f\x4e\x6c\x78\x54\xdc\xfe\x30\x09\x9c\x54\xab\xc9\xd0\x7d\xe2\x71\x3b\x85\x9c\x9b\x31\x9c\xdc\x77\x18\xba\x12\x3a\xa3\x12\xc8\x2f\x81\xd3\xa8\x4e\x4b\xff\xc3\x07\xb1\xac\x8e\x00\xc0\x7d\x68\x48\xee\xe2\x73\x0c\xa2\x33\xdd\xed\xe1\xe3\xe8\x0f\x86\xa8\x6d\xb2\x4f\xfe\x37\x00\xd5\xba\xbe\x4d\xbc\x14\x00\x00")
+
+func manifest_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_manifest_go,
+		"manifest.go",
+	)
+}
+
+func manifest_go() (*asset, error) {
+	bytes, err := manifest_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "manifest.go", size: 5308, mode: os.FileMode(420), modTime: time.Unix(1792411650, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _pin_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x9c\x57\x5f\x73\xdb\x36\x12\x7f\x26\x3f\xc5\x86\x33\xf6\x90\x09\x43\x2b\x99\xb6\x0f\xee\xe8\xe1\x52\x27\x57\xb7\x57\x37\x53\x25\xd7\x9b\xc9\x65\x12\x88\x5c\x8a\x1b\x93\x80\x0e\x00\xa9\xa8\x89\xbf\xfb\xcd\x82\x00\x45\xd9\x89\xaf\x57\x3f\x48\x22\xb8\xff\xf7\xf7\x5b\xac\xb7\xa2\xbc\x16\x1b\x84\x4e\x90\x8c\x63\xea\xb6\x4a\x5b\x48\xe3\x28\x41\x59\xaa\x8a\xe4\xe6\xec\x83\x51\x32\x89\xa3\xa4\xee\x2c\x7f\x91\x1a\x3f\xcf\x48\xf5\x96\x5a\x7e\x50\x86\x3f\xb7\xc2\x36\x67\x35\xb5\xc8\x3f\xf8\xc0\x28\xed\x34\x8c\xd5\x24\x37\x4e\xc6\x52\x87\x49\x9c\xc5\xf1\xd9\x19\x6c\x49\x3e\x97\x43\x0e\x4a\xc3\xe3\xde\x60\x0e\xba\x97\x06\x4a\xd5\x75\x42\x56\x06\x6a\xad\x3a\x10\x12\x50\xe8\x96\x50\x03\x7e\xb4\x5a\x94\x96\x94\x04\x92\xc6\xa2\xa8\x40\xd5\x6c\xc7\x36\x08\x5b\xb1\x6f\x95\x3b\x01\xdb\x90\x81\x35\x49\xa1\xf7\x45\x5c\x2a\x69\xac\xf7\x04\x4b\x48\x56\xaf\x57\x3f\x5e\x3e\xfb\xf5\x5f\xef\x5e\x5e\x5e\x25\x71\x6c\xf7\x5b\x0c\x86\xb1\xfa\x27\x6a\xc3\xe6\x8d\xd5\x7d\x69\xe1\x53\x1c\x5d\x90\x06\xf7\x37\xa6\xc0\xbf\xde\x73\x39\xce\x93\x8a\x74\xf2\x3e\x8e\x82\xca\x5d\x81\x61\x7c\xc3\x42\x2f\x7d\x70\x77\x85\x7c\xd8\xb9\xea\xc8\x62\xb7\xb5\x7b\x16\x5f\xd1\x1f\xe8\x9c\x92\xb4\xdf\x7d\x03\x33\x71\x43\x7f\x20\x4b\xfc\x43\x18\xfb\xda\x60\x05\x5c\xce\xe2\x15\x75\x18\x24\x5a\x61\xec\xbb\xde\x60\xc5\x62\x3f\xf4\x5a\xa3\xb4\x00\x6b\xa5\x5a\x98\x1b\x2a\xc7\x37\xc9\xfb\x38\x8e\x3a\x21\xa9\x46\x63\x73\x30\xb4\x91\xc2\xf6\x1a\x73\xb8\xc6\xbd\x8f\x36\xbe\x71\xcd\x6a\xc9\x58\x9f\xac\x01\x8d\xb6\xd7\xd2\xb8\xca\x1f\xda\x62\xa0\x97\x15\x6a\x08\x62\x17\xa4\x73\xe8\x94\xb1\xa0\xb1\x44\x69\xdb\x3d\x1b\xe2\xe0\xa0\x26\x6d\x6c\x11\xd7\xbd\x2c\x8f\x2c\xa7\x19\xa4\x6f\xde\xde\x6e\x48\x0e\xa8\xb5\xd2\x19\x77\x84\xa5\xdd\x33\x9c\x2f\x61\x44\x60\xf1\x1b\x8a\xea\x82\x74\x3a\x73\x9c\xc5\x11\xd5\x4e\xec\xc1\x12\x24\xb5\xac\x1a\x8d\x61\xf3\xa3\xb3\x10\x47\x37\x71\x34\x08\x0d\x43\xc8\xeb\xae\xeb\x38\xaa\x95\x86\x77\x39\xd4\xc4\x0e\xb5\x90\x1b\x74\x11\x3b\x83\x67\x67\xb0\xba\xa6\x6d\x30\x00\x24\x6b\x05\xcc\x00\x03\x42\x56\x47\xa5\x21\x09\x5b\xad\x36\x1a\x8d\x29\xe2\x88\x83\x7b\x50\x53\x71\xc9\xb1\xa6\x19\x7c\xfe\xec\xab\x6d\x8a\x1f\x85\x79\xa9\xb1\xa6\x8f\x69\x4d\xc5\x95\xe8\x30\xcd\x72\x48\x8a\xc4\x65\x1f\x45\xa5\x92\x96\x64\x8f\x71\xc4\xd1\x47\x15\xb9\x42\x04\xd6\x15\x3f\x29\x92\xe9\x51\x03\x26\x2b\x59\x1c\x45\x03\x0b\xdf\xce\xf1\xd3\x05\xe9\x73\x98\x79\xf3\xe7\x47\x67\x01\x72\xee\xf0\x17\x55\xbd\xa2\xf1\xdc\x63\x6c\x26\x0b\xcb\x65\x28\xc8\x05\xe9\xf1\xec\x66\x4c\x99\xeb\x33\x35\x4f\x99\x62\x65\x85\x4d\x39\x85\x47\x90\x14\x6e\xcc\x64\xdf\xbb\xd7\xcb\x43\xd3\xa2\xa1\x08\xbe\x61\xe9\x2c\x1c\xdc\xbb\xd7\xdc\x40\x0a\x1e\x2f\x65\xad\xf8\x94\x6a\x58\xf7\xf5\x97\x80\xf2\x82\x5a\xbc\xcf\xe7\xe9\x29\xf0\x69\xf1\x5a\x76\x42\x9b\x46\xb4\xa9\x33\x74\x3a\x50\x76\x14\x56\x34\x14\x13\x3c\x87\xc2\x53\x9c\x7f\x1e\xf8\x34\x14\x33\x46\x0d\x05\x73\x6a\x09\x03\xcd\xf4\x68\xa6\x48\xc5\x2f\x07\x4d\x2a\x56\x33\x55\x2a\x7e\xc6\x3d\x3b\xbd\xf1\x6d\x9f\xfa\xfd\xbb\x68\xaf\x39\x9b\x1c\x98\x4b\xe9\xd6\xc3\x28\x1f\xa1\xa8\x4c\xc1\xe9\x5e\x4e\x65\xf7\x3c\x72\x5f\x63\x1a\x9e\x25\x87\xe4\x43\x85\x31\xcd\x8a\x4b\xf3\x1b\x6e\xfa\x56\x30\x44\x43\xd2\x6e\x3a\x3d\xf2\x9d\xe0\x87\xb1\x0d\x1c\xd5\x8c\x60\x1c\xa7\x03\x5c\xa0\xd6\x12\xc4\x76\x8b\xb2\x4a\xc3\x49\x0e\x43\xe6\x18\xc8\xb7\x44\xb1\x6a\xa9\xc4\x95\x15\xeb\x16\x67\x12\x2e\x27\xca\xe1\x03\x90\xb4\xd9\x38\xc6\x66\x44\x0e\x82\x6f\xe8\xed\x84\x91\xe2\x6f\xb5\x45\x3d\xd9\x78\xf3\xe1\xf0\x8a\xdd\x65\xf1\x6d\xe5\xdc\x85\x3b\x0e\x39\xab\xfa\xb2\x09\x23\x5d\x63\xa9\x74\xc5\x43\x4e\xd8\x5b\x93\x0e\x84\x85\x67\xc2\x20\x5f\x0f\x3b\x61\xdc\x4c\xf3\xd3\x6c\x6e\x62\xac\x9a\x54\x3b\x86\xa0\x1b\xd5\x57\x6a\xc7\xe5\x52\xa6\xf8\xa1\xe1\x03\x93\x7a\x33\x8f\x3c\x16\x73\x90\x6a\xe7\x3e\x32\x1f\x53\x4d\xb2\x3a\x84\x64\x54\x3b\xa0\x01\xc1\x57\x5a\x0e\xbb\x86\xca\x06\x88\x9f\xd7\x3d\xb5\x55\x48\x2a\x07\x01\x15\x69\x2c\xad\xd2\x7b\x90\xa2\x43\xb6\xa4\x34\x08\xe8\x25\xfd\xa7\x47\xd8\xba\x11\x03\xaa\x66\x53\xfe\x7a\x6a\x84\x69\x7c\x16\x33\xa7\xe9\x96\xa4\x47\x55\x06\xe9\xc3\xfb\x66\xf3\xa1\xa4\x9e\x76\xc7\xa3\xfd\xff\x19\xc9\xb5\xea\x65\x75\xdf\x3c\x1e\x0e\xe3\x38\xb8\x75\x06\xa9\x86\x89\x98\x8c\x6a\x8e\xfe\xf3\x67\x18\x0a\xee\x95\x7f\xfe\x34\xc3\xea\xe9\x90\x07\xbc\x06\xed\x70\x5d\x3f\x58\x42\x92\x30\x25\xee\x8e\xe6\x19\xe1\xb7\x24\x3d\x39\xc6\x98\x27\xa0\xbb\xc7\x11\xe5\xd1\xcd\x88\xf4\x1d\xd9\xb2\x81\x16\xe5\xf8\xd2\xe9\x95\xc2\x20\x2c\xce\x6f\xd5\xa2\xee\x6c\xf1\x9c\x0b\x5b\xa7\x61\x93\x80\x13\x03\x64\x40\x2a\x7b\x98\xe0\xc9\xe8\xdf\x5b\x79\x32\xb3\x72\xea\x3c\xbc\x59\xbc\xf5\xe9\xdd\xc4\x7f\xd2\xbe\xe8\xd6\xb4\xe9\x55\x6f\x82\xed\x9b\xb0\xac\x49\x9c\x01\xf1\xb0\x00\x70\x45\x37\x34\xa0\x84\xf5\xde\x2d\x72\xa0\x34\xcc\xf7\x2c\x8f\xa9\x23\x0b\x69\xe6\xab\xca\x25\xa0\x1a\x1e\xb2\xde\x58\xf0\x19\x2c\xf8\x74\x1e\xba\x32\xc5\xdf\xd1\xa2\x1c\xd2\x71\xa3\x0b\xc1\xf5\x06\x5f\x1e\xc5\x37\x56\x1a\xcd\xc4\x52\xab\xbe\xbc\x4a\xe6\x20\x5a\x25\x37\xb0\x23\xdb\x84\x5d\x32\x0c\x70\x20\xeb\xd8\x3d\x55\xdb\xad\xa4\x05\xbc\x9a\xcb\x34\xc2\xb0\xf1\x35\xba\xed\x09\x2b\x2e\x82\x6d\x1c\xdf\x78\xde\xcf\x96\x51\xb0\xba\x37\xd6\xe4\xd0\xd2\x35\x02\x59\x03\x6a\x27\x0b\xb8\xb4\x53\x35\x6b\xd1\x1a\x84\x5d\x83\x72\x2a\x2c\x99\xfb\x37\xdc\x30\x03\x2c\x2b\x91\x81\xb2\xc1\xf2\x1a\x2b\x70\x43\xa9\x17\xad\xaf\xfd\xed\x02\x1d\x93\x9a\xe7\xea\x11\x8f\x27\x02\xdf\x9a\x03\xf7\x51\xd8\x05\x7f\x20\xb1\x63\x52\x58\x40\xef\x8a\x05\x4c\xb6\x6a\xb3\x41\x5d\x5c\xe0\xba\xdf\xa4\xc9\xed\x30\x93\x1c\x92\x2d\xc9\x11\x89\x39\xb8\xa5\x3b\x1f\xb9\x9c\xc3\xb4\x61\xe7\x07\xc6\x67\x71\x14\x3a\x7e\xef\x52\xe4\x4c\xb0\x30\xc9\x2f\xc9\x7a\x1b\x39\x24\x6b\x92\xc9\x21\xeb\xf3\xe5\x58\xe0\x15\x96\xbd\xc6\x57\x1a\x31\x5d\xf5\xa6\xa1\x67\xea\xa3\x13\xf7\x7a\xd9\xf7\x7f\xae\x46\x01\x44\x3f\xad\x7e\xbd\xca\x27\x48\x4d\x17\x3f\x2c\xbf\xb6\x4d\xcc\x03\x1a\x50\x53\xbd\x9f\x94\xd2\xbb\xce\x5d\x2b\x18\x8c\x0f\x96\x0e\xa4\x24\x37\x3f\xe3\xfe\x68\x0a\xfa\xc0\xbe\x32\x15\x98\x04\x1e\xdd\xcc\x13\x10\x52\xd9\x06\x35\x03\xfc\x1c\x4e\x86\x79\x03\x5c\x72\x7e\xe2\x7d\x25\x6b\x7f\x6a\x75\x8f\x39\x30\xa4\xc3\xda\x93\x3a\x3a\x8f\xb3\x42\x93\x3c\xdc\x1c\x3b\x20\x55\xfc\xae\xc9\xa2\xce\x41\x18\x2e\x97\xdb\x05\x66\x8b\xcc\x5f\xbf\x7a\x66\x80\xf5\xa6\x43\xcd\xa6\xd5\x65\xbe\x8c\x4e\x87\x5f\xb8\x9c\x3e\x85\xf5\x0c\x65\xc9\x31\xb8\x3d\xf2\x0a\x77\xcf\xf9\x3f\x69\xd4\xe9\x2e\x1b\xdf\x15\x2b\xb4\x97\xb2\x42\x69\xd3\x84\x21\x0e\x90\x64\xb3\x80\x64\x59\x8c\x1a\xd3\x16\x33\x2e\x4a\xff\xe3\xe6\xeb\x84\xbe\xe6\x57\x09\x24\x71\x74\x97\x7f\xe3\xfb\x25\x24\x0f\x93\xb0\x44\x76\xb6\x78\xe1\x2a\x5d\xa7\xbb\x1c\x92\x13\x03\x27\x8f\x9f\x2e\x0c\x9c\x3c\x59\x54\xdc\xf7\x13\xf3\x6f\x66\x17\x2b\x1e\xb5\x78\x5c\x02\xf9\x7b\xda\xba\x5e\x28\xdd\x09\x9b\x26\x4f\x17\x8b\xef\x1e\x2f\x9e\x3c\x5e\x3c\x85\x27\xdf\x9e\x2f\xbe\x39\x5f\x7c\x9b\x64\x07\xbe\x1d\xdd\x42\xf1\x4d\xfc\xdf\x01\x00\x76\x7d\x1b\xa3\x7d\x10\x00\x00")
+
+func pin_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_pin_go,
+		"pin.go",
+	)
+}
+
+func pin_go() (*asset, error) {
+	bytes, err := pin_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "pin.go", size: 4221, mode: os.FileMode(420), modTime: time.Unix(1792411782, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _restore_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa4\x95\xdf\x6f\xdb\x46\x0c\xc7\x9f\xad\xbf\x82\x13\x30\x40\x02\x34\x25\x6f\x03\x32\xf8\x21\x48\x53\xb4\x03\x5a\x14\x6b\x86\xbd\x14\x18\xce\x12\x65\x73\x3e\xdd\x69\x47\x3a\xa9\xb0\xe6\x7f\x1f\x78\x27\x39\x4e\x62\x14\x36\xfa\x62\xeb\x7e\xf0\xc3\xe3\x97\xe4\xdd\x60\x9a\xad\x59\x23\xf4\x86\x5c\x96\x51\x3f\xf8\x20\x50\x64\x8b\xbc\xeb\x25\xcf\x16\x39\xf9\x0b\xf2\x3b\x21\xab\x03\xcf\xfa\x3b\x18\xd9\xcc\xff\x17\x1d\x59\x9c\x27\xd8\x87\x68\xc3\x12\xc8\xad\x39\xcf\xca\x2c\xbb\xb8\x80\x7b\x63\xa9\xbd\x66\x46\xf9\x68\x7a\x84\x80\xff\x60\x23\x0c\xce\xf4\xc8\x20\x1b\x23\xf0\xe0\x77\xb6\x85\x80\xec\xed\x3d\x82\xdf\x09\x53\x8b\xe0\x3b\x90\x0d\x2a\xa1\xa5\x80\x8d\xf8\x30\xea\xc4\x08\x26\x28\x85\xc5\x07\x6c\x81\x9c\xf8\x3a\xeb\x76\xae\x79\xe1\xa8\x50\x07\x90\xce\x52\x02\x86\xe0\x03\xfc\x97\x2d\xf8\x81\xa4\xd9\xe8\x57\x63\x18\xe3\x29\x60\xb9\x84\x3c\xbf\xca\x16\x8b\x80\xb2\x0b\x0e\xba\x5e\xea\x5b\x35\xe8\x8a\x9c\x5c\xc4\x82\x51\x6e\xdc\x7e\x05\xd8\x0f\x32\xe6\xe5\x84\x98\xc2\xad\x6f\xbc\x13\x43\x8e\xa3\xe3\x0a\xf2\x2f\x5f\xf2\xf2\x64\x28\xfc\xfc\xef\x15\x34\x13\x01\x56\xa6\xd9\xb2\x35\xbc\xc9\xab\xb8\x3a\xbb\x52\xa5\xeb\xf7\x7c\xbd\x4a\x4e\xce\xc4\x9b\x15\x7b\xbb\x93\x84\x39\x46\xbe\xb1\x68\x5c\x22\xc3\x4f\xcb\x14\xec\x59\x1e\x9c\x17\x68\x8c\xf3\x8e\x1a\x63\x5f\x78\xd8\x2b\x5d\xd7\x39\x7c\xfb\xb6\x97\xed\x9d\xe1\x4f\x01\x3b\xfa\x3a\xeb\x56\xd7\x17\xe7\x0a\x87\xdc\x98\x21\x56\x13\x42\xf0\x5e\x9e\x5c\x3f\x66\x33\xc6\x91\xcd\x1e\x63\x41\x46\xe3\x4f\x46\x36\x90\x96\x92\x9d\x2a\x30\xd5\xdc\x21\x7e\xe7\x5a\x0c\x5a\x81\x15\xf8\x00\xc6\x4d\x95\x44\x9d\x92\x74\x6f\xdc\x45\x1c\x63\x67\xd3\x21\x88\x9f\xab\x73\xaa\xcb\xbd\xbf\x22\x62\x9e\xd5\x65\x91\x3e\xaa\x84\x2d\xb5\x2e\xa9\xd3\x01\x5c\x2d\x8f\x15\x74\xf9\x5b\x5c\xd4\xec\x90\xd5\xdd\x73\x78\x79\x1e\x19\x87\x11\xcf\xbd\x59\xff\xee\xc9\x25\xdf\xfb\xa9\xb7\xc1\xf7\x9f\xb5\xc2\x12\xb5\xac\x0e\xf4\x99\x4e\x1f\x1d\xc3\x43\x20\x41\x06\x03\x4c\x6e\x6d\x67\x69\xf6\xaa\xd4\xf0\xa7\xb3\xb4\xc5\x28\xc5\x1f\x07\x86\x0a\x5a\xa3\xc3\x60\x04\x5b\x58\x8d\xb0\xf6\xbf\xac\xc8\xb5\x46\x4c\x05\x24\x29\x36\x23\x53\xd2\x52\xea\x9b\x80\x71\x66\x30\x01\x9d\xb0\x22\x86\x40\xf7\x46\xa2\xa8\xba\x6f\xc7\xa8\x49\x68\xc1\x0c\x83\xa5\xc9\xb8\xf7\x2d\x42\xc0\xb5\x09\xad\x45\x66\x4d\xe2\xae\x37\xbc\x9d\xe4\x3f\x0c\xe7\x48\x06\xf6\x37\xc3\x50\xcd\xba\x1f\x49\x58\xb9\xcf\xcb\x6b\xe9\x67\xd9\x53\x6c\x13\x23\xb9\x3b\xdf\x74\x09\x2d\x36\x61\x1c\xe4\x09\x50\x81\x2e\x9f\x82\x49\x80\x7b\x0c\xd4\x8d\xd1\xfe\x8d\x11\x73\x26\x83\x5c\xe7\x9f\x47\xf1\xde\x75\xfe\xe4\x48\xd2\x11\x3c\xd7\x1f\xb6\x2d\x85\x6b\x6b\x8b\x7d\xcd\xbd\xa1\x50\x0c\x65\xa5\x8b\x6f\xc9\xe2\x07\xdf\x62\x71\xf9\xeb\xe5\x65\x79\x3a\x37\xbd\x45\xf5\x5f\x5a\x94\xca\x28\x86\x14\x58\x35\x67\x39\x42\x35\x84\x3a\x7e\x95\xe5\x59\x67\xbe\xd9\xf4\xbe\x2d\x86\x1f\xa3\x4d\xc3\x88\x13\xea\x91\x15\x38\x43\xee\xa8\xc7\xa2\x7c\x39\x2e\x8f\x74\x1e\xcf\xad\x87\xf7\x18\xc6\xd7\x7d\x77\x6d\xed\xf4\x86\x9a\x80\xfb\x76\x6a\x15\xb3\xc2\xce\x07\x04\xe3\x46\xd9\x90\x5b\x03\x25\x96\xa0\xab\x62\xf3\x04\x5c\xef\xac\x09\xf1\x36\xe0\xe7\xcf\xe9\x93\xa9\x82\x78\xec\x2d\xb9\x2d\xe0\x57\x62\xe1\x0a\xd8\x83\xf3\xe9\x5c\x7a\xcf\xc3\x4a\x2d\xd3\xeb\x8c\xed\xe1\xc3\xad\x27\x7c\xdd\x7d\xac\xfd\xf4\xba\xf1\x52\x14\x73\xb5\xe9\x55\xc7\x45\x99\x2d\xd8\x07\xa9\x3f\xc7\xcd\xe9\xb9\xe3\x32\x5b\x74\x3e\xc0\xdf\x53\x07\x5f\x2d\x21\x18\xb7\xc6\x49\x06\x4d\xc5\x99\x77\xe7\xb3\xd4\x69\xee\x1e\xf7\x1e\x0e\xf0\xbd\x71\xd4\x21\xcb\xf7\x3d\x60\xfd\xf1\x34\x1f\x11\x51\xdf\x8d\x43\x7c\x0e\xd1\x49\x18\xdf\xe9\xd5\xa5\x42\x47\x83\xef\xb8\xb8\x33\x61\x8d\x72\xc4\xc9\x73\x2f\x8b\xc7\x39\x9c\x33\x14\x3b\x7e\x4f\x9e\x26\x1a\x75\xd0\x62\xbb\x1b\xf0\xd6\x99\x95\xc5\xb6\x28\x5f\xc0\xd3\xaa\xb6\x6c\x2c\x82\xd3\xa8\xd3\xd4\x74\xb0\x5b\x27\x81\x26\xf3\xec\x31\xfb\x7f\x00\x34\x4c\xa4\x51\xba\x0a\x00\x00")
+
+func restore_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_restore_go,
+		"restore.go",
+	)
+}
+
+func restore_go() (*asset, error) {
+	bytes, err := restore_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "restore.go", size: 2746, mode: os.FileMode(420), modTime: time.Unix(1792408176, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _secure_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x55\xc1\x6e\xe3\x36\x10\x3d\x5b\x5f\x31\x6b\xc0\xa9\xd4\x0a\x4a\xb0\x97\x00\x59\xf8\x12\x6c\xda\xa2\x68\x81\x45\xdd\x5e\xbb\xa0\xc5\x91\x4d\x44\xe2\x08\x33\x54\x6c\xa3\x9b\x7f\x2f\x86\xb4\xbc\x4a\x93\xdd\x38\x97\x44\xa2\x86\x6f\xde\x7b\x7c\x43\xf7\xa6\xbe\x37\x1b\x84\xce\x38\x9f\x65\xae\xeb\x89\x03\xe4\xd9\x6c\xde\x74\x61\x9e\xcd\xe6\x24\xfa\xb7\x37\x61\x7b\xd9\xb8\x16\xf5\x41\x17\x24\xb0\xf3\x9b\xf8\x4d\x0e\x52\x9b\xb6\x9d\x67\x45\x96\x5d\x5e\x82\xf3\x82\xf5\xc0\x78\xeb\x82\x80\x61\x04\x8f\x0f\xc8\xc0\x28\x81\x18\x6d\x09\x42\x10\xb6\x26\x80\xa7\x35\xd9\x03\xac\x87\x00\x61\x8b\x40\x3b\x8f\x0c\xb5\xf1\x0a\xd2\x91\x75\xcd\x01\x76\x5a\x27\x83\x6c\xdd\x9a\xf6\xe0\x04\xcc\x9a\xb4\x9c\x00\xf7\x58\x57\x59\x4d\x5e\xc2\xd3\x8e\x4b\x20\xa9\x7e\x76\x2d\xfe\x41\x16\xf3\xab\xab\xf7\xef\x8b\x2c\x6b\x06\x5f\x8f\x0c\xe2\x7a\x47\x16\xa7\x85\xc5\xf4\x05\xfe\xcd\x66\x8c\x61\x60\x0f\xb1\xee\xe2\x9f\x27\x2d\xb2\xc7\xa8\xb3\xde\x62\x7d\xbf\x8a\xab\x9f\x4c\xd8\x02\x63\x33\x08\x0a\x18\x50\x8f\x92\x44\x27\xe0\x29\x44\x69\x16\xd6\x87\xa8\xb3\x1e\x98\xd1\x07\x18\x04\x59\x71\x88\x4f\xb5\x3b\x76\xc1\xac\x5b\xd4\xd2\x0d\xd3\xd0\xeb\x47\x0a\x5b\x64\xa9\x60\x75\xe8\x5a\xe7\xef\x23\xa5\x64\xac\xdb\x78\x75\x14\x8c\x28\x4e\xd8\xe2\x21\x2e\x9b\x76\x67\x0e\x02\x57\xd7\xd7\xd7\x55\x52\xfe\x3f\xaa\x79\x0f\xe9\xf8\x0a\x40\x66\x62\xd5\xeb\x7c\x43\xa5\xbe\xc2\x4d\x74\xf0\x77\x09\x26\xe4\x7d\x91\xcd\x5c\x13\x97\xdf\x2d\xc1\xbb\x56\x4b\x47\x6f\x90\x39\x9b\x3d\xc6\x02\x09\x25\xd0\xbd\x6e\x55\x9c\x6a\x75\x90\xbc\xa8\xf2\x1f\x8f\xc9\xa8\x56\xc1\x84\xcf\xa1\xf8\xa0\x35\x17\x17\xe0\x7c\xc8\x25\x54\x7f\x3b\x5b\x28\x2c\x49\xf5\x0b\x86\xc1\xd9\xbc\x98\xc2\x37\x5d\xa8\xee\x94\x5e\x93\xcf\x47\xfb\x93\xb5\x0b\xb9\xf9\x6a\xe9\xe0\x2c\x2c\x6c\x19\x7d\x5e\xd8\x79\x09\x7d\x09\x09\xbc\x9c\x22\x17\x23\xd5\x48\x30\x66\xa0\xb8\x20\x89\x4f\xa3\xb3\xcb\x25\x5c\x25\x7e\x5f\x4b\x9e\x64\xeb\x9d\x16\x9c\x4d\x31\x66\x67\x21\x7a\xb0\xa7\xc3\xdc\x11\xb7\xf6\x74\xce\x89\xed\xa4\x5d\x62\x79\x44\xf7\xae\x7d\x1e\xb5\xbf\x18\x31\xbd\x0b\x30\x51\x00\xe3\x2d\xe8\x80\x1d\x52\xe3\x9a\xba\x9e\xbc\xe6\x6b\x8d\x2d\xed\xc0\x05\xb0\xb4\xf3\x10\x08\xfa\xe7\x69\x50\xb4\x5c\x61\x4a\x78\x21\x13\x8c\xed\x29\x12\xe3\xec\x57\x7f\x62\x3b\xee\x38\x33\x1c\x8c\xad\x5a\x3b\xaf\xaa\x39\x7c\xf9\x72\x6c\x23\xd5\xaf\x46\x3e\x31\x36\x6e\x9f\xc7\x3e\xfa\xf9\xa7\xf4\x2d\x27\xa9\x34\xa7\x2b\xec\x0d\x9b\x40\x5c\xbc\x25\x18\x43\x10\xa7\xc3\xdd\xc0\x42\x92\xc1\x4a\x37\x3a\x9b\xcd\xea\x21\xaa\xd1\x95\x13\xf9\x9b\xe5\xb3\x01\xa9\x07\x2e\x3e\xbc\x41\xd9\x7c\x5a\xa0\xe7\xa6\x05\x0d\x31\x7c\x2e\xa1\x8e\x0d\x8d\xdf\xe0\x49\xfa\xaa\x6f\x5d\x48\xb2\xbf\xaf\x58\xe9\x4e\xbc\xff\x8d\x9c\x57\x6e\x25\xd4\x45\x36\x7b\x2b\xff\x27\x02\x66\x8f\xdf\x89\xda\xdd\x1e\x6b\xc5\x81\x07\x64\xd7\x38\x94\x74\x71\x51\xd7\x69\xda\x8c\xb7\x25\xe8\x24\xc5\x3b\xcb\x80\xa4\xf1\x29\xd3\x6d\xed\x82\xc2\x30\x0a\xb5\x0f\xba\x91\x60\x8d\x0d\x31\x6a\xf9\x06\x83\xc4\x9b\xfb\x07\x3b\xcd\xe2\xd8\x2e\xaf\x3b\xab\xff\x5f\xb8\x9d\x5e\x52\x1a\xc3\xbb\xd2\x1f\x87\x5b\xda\x7f\x74\xea\x4a\xda\xff\xea\xd1\x1d\xd9\xd9\xe7\xe9\xbe\x7b\x30\xed\xf1\x3a\x90\x91\xce\x39\x31\x5f\x1b\xc1\x57\xd0\x6e\x8d\xe0\x47\xc7\x67\x0e\xcd\x37\xe4\xa6\x3e\x23\xff\x33\x84\x4e\x8e\xf7\xbf\x01\x00\xae\x17\xd1\xbd\xea\x07\x00\x00")
+
+func secure_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_secure_go,
+		"secure.go",
+	)
+}
+
+func secure_go() (*asset, error) {
+	bytes, err := secure_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "secure.go", size: 2026, mode: os.FileMode(420), modTime: time.Unix(1792407827, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _selfupdate_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x59\x61\x73\xdc\x36\xce\xfe\xbc\xfa\x15\x88\x66\x92\x91\x6a\x59\xb6\x93\x3a\x33\x75\xba\xef\x8c\xe3\x38\x6d\xdf\xa6\x49\xc6\x76\xdb\xbb\xf1\x65\x52\x4a\x82\x56\x3c\x4b\xa4\x8e\xa4\xd6\xde\xc6\xf9\xef\x37\x20\x29\xad\xb4\xeb\x73\xf6\x43\xe2\x25\x09\x80\x00\x08\x3c\x00\xa9\x96\xe5\x37\x6c\x81\xd0\x30\x2e\x82\x80\x37\xad\x54\x06\xa2\x60\x16\xe6\x6a\xd5\x1a\x79\x80\xc5\xf3\xe3\xe3\xa3\x1f\xc2\xf5\x8c\xae\xd8\xf3\xe3\x97\x34\x81\x22\x97\x05\x17\x8b\x83\x8c\x69\x7c\xf9\xfd\x64\xaa\xc2\xbb\xc9\xf8\xdf\x5a\x0a\x9a\x28\x1b\x43\x7f\xb8\x74\xff\x1f\x70\xd9\x19\x5e\xd3\x40\xa0\x39\xa8\x8c\x69\xfb\xdf\x9d\xb2\xd3\x52\xd3\xff\x2d\x33\x55\xff\xf7\xa0\xe4\x35\xf6\x13\xda\xa8\x5c\x8a\xa5\xff\xc9\xc5\xc2\x92\x1b\xde\x60\x18\xc4\x41\x70\x70\x00\xa7\xa0\xb0\x46\xa6\x11\xb4\xec\x54\x8e\xc0\x35\x30\x28\xb8\xc2\xdc\x48\xb5\x02\xa9\x80\x09\xf8\xf9\xea\xea\x63\x74\x19\xc3\xef\x17\xef\xe0\x96\x9b\x0a\x4c\x85\x90\x75\xbc\x2e\x34\xc8\x12\x18\x49\xca\x3a\x51\xd4\x08\x4c\x14\xc4\xc1\x45\x81\x77\xb4\x66\x2a\x6c\x12\xd0\x7c\x21\xb0\x58\xf3\xde\xe0\xca\xcb\xb0\x4c\xb7\x4c\x7b\x12\x92\x44\x54\x29\xe8\x4e\x57\xbc\x61\xda\xa0\x82\xb6\xcb\x6a\xae\x2b\x7b\x0e\x86\x71\xa1\x21\x93\xa6\x4a\x83\x5c\x0a\x6d\x0f\xc4\x1b\xf1\x0b\xed\xfa\x96\xd7\x08\x73\x08\xad\x0a\xa9\x77\xad\x27\xb8\xe4\x0b\xbb\x0c\x53\x82\x54\xf3\x85\xf5\x88\x59\xb5\x08\x63\x61\xa0\x8d\xea\x72\x03\x5f\x82\xd9\x7b\xd6\x10\x23\x80\x73\x25\xfd\xfa\x8b\x98\x4f\x42\xc1\x1a\x4c\x64\xc3\x0d\x36\xad\x59\x85\x7f\x05\xb3\x0b\x27\x42\xc3\xf5\xa7\xde\xbf\x9e\xd6\x0f\x75\xf8\x57\xf0\x75\xba\xdf\x68\xab\x3f\x50\x69\x2e\xc5\x7a\x2f\xcf\xbc\x74\xf3\xb4\xc3\x25\x36\x4b\x54\xb0\x45\xa2\xed\xfc\x54\x9b\xd7\x74\x52\x57\xbc\xc1\x0d\x5a\x7b\x82\x9f\x29\x1c\xa6\xf4\xde\x45\x5b\xb2\x29\xb4\xec\xde\xfc\x6f\xbf\xce\x85\x79\xf9\xfd\xe0\x07\xcd\xff\x76\xeb\x3f\x9f\x3e\x3f\x7e\xf9\x80\x6e\x2e\x39\xac\xe5\x07\x07\x80\x77\x98\x77\x86\x65\x35\x7e\x64\xa6\x02\xae\x5d\x48\x70\xc1\xd4\x0a\xf6\x35\xd6\xe5\x7e\xd7\x16\xcc\x20\x28\x6c\x6b\x96\xa3\x4e\x83\x25\x53\x9b\x7c\x73\x90\x3a\x3d\x1f\xe6\x02\x4b\x43\xb9\x72\x56\x73\x14\x06\xe6\xf0\x8c\x46\xa9\x1b\x7e\x21\x3f\xc8\xce\x9c\xc0\x31\x7c\x07\x64\x7b\xfa\x1b\x17\x9d\x41\xa7\x53\x89\x26\xaf\xfc\xe9\x81\x42\x56\x68\xa0\xd3\x85\x52\xc9\xc6\xa7\x48\x02\xb7\x15\xcf\xab\x87\x32\x85\x12\x24\x0d\xca\x4e\xe4\x13\x41\x51\xcf\x68\x45\x39\x9f\xc4\x10\x5d\x7f\xca\x56\x06\x13\x40\xa5\xa4\x8a\xe9\xdc\x79\x49\x03\x38\x99\xc3\x92\xd5\xbc\x38\xd5\x1a\x0d\x85\x5d\x44\x8c\xf1\x2b\xbb\xf8\x64\x0e\x82\xd7\x44\x3d\x53\x68\x3a\x25\x68\x68\x85\x04\xb3\xaf\x56\xc4\x13\x9f\xeb\xe9\xcf\x4c\x7f\x54\x58\xf2\xbb\x41\x81\x90\x3c\x71\x72\x70\x10\xc6\xf0\xec\xd9\x37\x09\xb5\xa3\x1c\x6d\xe5\x00\x29\xbd\x40\x56\x50\x90\x44\x3d\xd8\xa4\xff\x2f\xb9\x18\x98\x87\xd9\xb7\x4a\x36\x97\x35\xd3\x95\x33\x20\x8e\x49\xc5\x60\xd6\x25\xbd\x99\x9d\xaa\xd3\x8f\x4c\x0d\x2e\x8a\x07\x1f\x7c\xc3\xcc\x2e\xf5\x67\xbf\xde\xdd\x4d\x39\x27\xc7\xc1\xac\x96\x8b\x05\xaa\xf4\x0d\x66\xdd\x22\x0a\xc7\xc7\x11\x26\x10\x12\x7e\x26\xd0\xa5\x97\xd6\x03\x11\x69\xa6\x50\xb7\x83\x62\xeb\xf8\x49\x7f\x42\x13\x4d\x08\x77\xd3\xb0\xc0\x12\x15\x90\xd0\xf4\xb5\x2c\x56\xe9\x59\x2d\x35\x46\x8e\xdd\xce\x5e\x1a\x66\x3a\x7d\x26\x0b\x84\x27\x6e\x43\x3f\xf5\xe1\xd7\x2d\xa1\x65\x63\xd2\x73\x0a\x93\x32\x0a\x7f\x3a\xbf\x82\xa7\xfa\x04\x9e\x6a\xb2\x20\x19\x0b\xb3\xfe\x7d\xe0\xac\x4e\xeb\x3a\x1a\x34\x89\x7d\xfa\x59\xf8\x3b\x35\x36\xed\x35\x70\x0d\x95\xbc\x05\x59\x1a\x14\x36\x0f\xed\xb2\x85\x73\x6e\x1c\x3a\x33\xd3\x29\x04\xa6\xd0\x05\xb7\x03\xeb\x0c\x4b\x49\xb3\xd0\x70\xdd\x30\xe3\xf2\xc2\xb0\x1b\x14\x40\xa0\xde\xe5\x15\xa1\xbf\xc8\xf1\x41\x40\xef\x13\x9b\x24\x51\xa5\x00\x29\x10\x58\x49\x24\xa4\x83\x34\x15\x2a\x57\x52\x6c\x32\xa2\x82\x86\xad\x60\x81\xc6\x12\xca\x12\x90\xe5\x43\x1d\x98\x1a\x34\x87\x17\x0e\x0a\xec\xf4\x05\x1a\xb5\x7a\x83\x35\x5b\xc1\xdc\x65\xfd\x25\xe6\x52\x14\xd6\x13\xb5\x64\xc5\xc5\x18\xf4\x9d\x7d\x7a\xe4\x07\x59\xf6\x05\x92\xb4\xc9\x2b\xcc\x6f\xf4\xd4\x31\x29\x49\xfa\x20\xea\x55\x5f\xec\x5c\x6d\xd3\x90\x33\x01\x1e\xc7\xc8\x44\x8d\xf5\x12\xb5\x47\x89\xcd\x9d\x7d\x1a\xac\x31\xe2\xbb\x71\x31\x1a\x43\xc5\x0d\xae\x86\x60\x5d\xa2\xe2\xe5\xea\x57\x5c\x45\x3b\x47\x27\x2f\x6d\x0d\x9e\x3f\x4c\x35\x0e\xb7\x31\x0e\x0b\xc4\x42\x03\xf3\xa6\xd9\x0e\xc0\xb8\xa2\xbe\x4f\x46\xef\x93\x48\xa9\x5c\x64\x0e\x4a\x9d\x8b\xa5\x8b\x4b\x3a\x8c\xac\x2b\xc1\xe1\x5e\x30\x2b\x09\x32\xdd\x71\x91\x15\x47\xaf\xe0\x55\x3f\xde\xdb\xb3\x3a\xf1\x92\x18\x9c\x9d\xf3\x87\x21\x75\xb3\xf2\x6f\xa3\xe4\xb6\xfd\xa4\xcc\xcc\x36\x5f\x58\x0c\x4e\x7c\x4c\xba\x6f\x1b\x62\xa7\xd2\x6e\xf2\x35\x5f\x0c\xb2\x5d\x0b\x98\x5e\x9a\xe2\xdc\xb7\x7c\xe9\x1b\xa4\xed\x3d\xaa\xf4\x38\x7c\xa5\x78\x73\xd9\xb2\x1c\xfd\x4c\xe4\x95\x8c\xe3\xd1\xd6\xfe\xcc\x9e\x3d\x03\xdf\x7e\xa6\x7f\x58\x57\x47\x36\x24\xac\xbf\x34\x5f\x38\xd8\x9e\x65\x0a\xd9\x8d\xd7\x88\x97\x83\xbb\xe7\xf3\x8d\x6c\xd9\x32\x64\x1c\x02\xde\x0b\x8e\x65\x04\x05\x85\x44\x0d\x42\x1a\xb0\x89\x1f\xc6\x7e\x9f\x29\xf2\xee\xc6\x4c\xae\x36\x6a\xc5\xc5\x82\xd0\xd9\xab\x19\x26\xbd\xc2\x24\xda\x65\x6d\x8d\xd8\x46\x1b\x29\xed\xc2\x8b\x17\x77\xe4\xeb\x67\xe3\x88\xf8\xf2\x75\x5c\x54\x6d\xab\xf7\xbb\x68\x98\xd2\x15\xab\x23\xeb\x2a\x5e\xdc\xed\x56\x58\xfd\x1c\x2f\xee\x12\x5a\xf0\x20\x9a\xcb\xa6\x65\x0a\x7d\x37\xe6\x47\x1a\xcc\xad\x04\x8d\x0d\x13\x86\xe7\xe0\xbb\x36\x9d\x00\x5f\x08\x49\xe7\xea\x7a\x67\x68\xd0\xb0\x82\x19\xe6\xc1\x60\x22\x2b\x62\x09\x64\x03\x0e\x70\x61\xfb\x42\xdd\xd6\xdc\xe6\x0a\xd1\x47\xcb\x51\x2b\xf1\xe2\x13\x17\x26\x19\x26\xc8\x88\x25\xcc\x61\x1c\x58\xbe\xc2\x2f\x13\x08\x97\xa1\x8f\x27\x0e\x27\x6b\x22\x87\x40\xb4\xbe\x17\xc6\xaf\x80\xc3\xff\xcd\xe1\xd0\x45\x06\xc9\x5a\x5e\x9f\xf0\x4f\xfe\x88\x5b\x85\xc4\x19\x86\x8f\x8a\xd9\xdf\x12\x93\x00\x71\x7a\x59\x09\x2c\xaf\xf9\xde\xd1\x49\x2f\x94\xf0\x41\x80\x33\x25\x98\x59\x78\xe0\x09\xb4\x24\x5b\x31\xb1\xc0\x61\x87\x4b\x72\xc3\x7b\xbb\x45\x1a\x26\xf0\xc2\xc7\xba\xb8\x26\x99\x9f\x9d\xd9\x74\xef\x49\x4f\x8d\xe4\x51\xdb\xc7\x65\x7f\xaa\x56\x09\x7b\xa6\x4c\x24\xc0\xbc\x2d\xd6\xb7\x11\x8b\x83\x59\x26\x12\xc8\x26\xb3\x59\xec\xd0\x8a\xaf\x75\x61\xa2\x47\x28\x46\xfb\x52\xf4\x64\xf6\x87\x55\x65\x98\xfe\x71\x3c\xdb\x6b\xb0\x7f\x14\xcc\x9c\x4a\xfd\xcc\x91\x53\xf1\x6b\x30\xd3\xb7\x9c\x8a\xe8\x97\x60\x96\x53\xd6\x58\xed\xe6\x73\xab\xcf\xc9\xda\x86\xc3\x8d\xe5\x30\x1c\x2d\x1e\xf9\xc5\xec\xa1\xc5\xfd\xa3\x71\x34\xf7\x1e\x3d\x73\xa1\x17\x91\x3c\x67\x7c\xdf\x25\x50\xd6\x69\xc3\x9a\xb6\xbf\x93\xf8\x36\xbd\x66\x2b\xd9\x19\xaa\x8b\x2e\x96\xfb\x20\xf7\xcd\x31\x53\x68\x93\xdb\x54\x48\x52\x5a\xb6\xa2\x52\x07\x15\xd3\x15\xf1\x30\x50\xd8\x2a\x59\x74\x39\xcf\x7c\x21\x29\xfa\x32\xbe\xb5\xe3\x1c\xc2\xe7\x87\x87\x2f\x0f\x8f\x0e\x9f\xef\x1f\x1d\x1f\x7e\x7f\x78\x1c\x8e\x73\xaf\xef\xd6\xa5\x2a\x50\xe9\x1e\xb2\x35\x64\xab\xad\x14\x84\xdb\x0a\x85\xbd\x3b\x42\xc5\x96\x08\x52\x60\xe2\x5b\x0f\x41\xf4\xce\x14\x7b\x21\xda\x98\xdb\x16\x40\x16\x0e\xaa\xea\x14\xae\x9c\xa5\x64\x21\xda\x5b\xf1\xb6\x85\x1a\x0a\x69\xbd\xa2\xa5\xa2\x64\x95\x6b\x65\x9d\xd7\x24\xb5\x0f\x05\x2f\x4b\x54\xae\x43\x73\x4d\x51\xce\x04\x71\x65\xde\x46\x2c\xa6\x80\xd1\x17\x2d\x8b\x18\x5e\x62\x0c\x91\x05\x84\xc9\xdd\x82\xa5\x1e\xa7\x9e\x50\x50\x50\x05\xc9\xa6\x33\x3e\xa2\x73\x0a\xf3\x0d\x34\xf2\x84\xc9\xc0\x12\xbf\x82\x1c\x9e\x0c\xa9\xed\x03\x2a\x77\xe0\xd8\x47\xb3\xdd\x74\x38\xc7\x39\x64\xc3\x60\x04\xb3\x87\x9e\x87\x12\xd2\x24\xc0\x3c\x5a\x5b\xbc\x77\x97\x03\xfb\xf3\xe2\xed\xd9\x8b\x17\x2f\x7e\x48\x80\xa5\xc3\x85\x96\xb2\xd5\x24\x90\x7d\x8b\x25\x9b\xb0\x90\x56\xd3\x4a\x9a\x4d\x87\x4f\x98\x49\xcf\xff\xd3\x51\x8d\x30\xf1\x90\xe7\x26\x7d\x6d\x9b\xdd\x61\x72\x9d\x53\x23\xab\x87\x2c\x5c\x5b\xf5\xd9\x1b\xb5\xa5\xe0\x38\xcc\x93\xb5\xa7\x62\xcb\x92\xed\xc0\x92\x8d\x58\x7a\xab\x7c\x1d\xbb\xbf\x87\x6c\x34\x9c\xfa\x7b\x5c\xdd\x7d\x74\x19\xac\x6b\x0a\x6f\xdb\x72\x3f\xd5\xae\x8f\x03\xae\x41\xe0\xad\x3d\x77\x97\x1b\x14\x8f\xbe\xe3\xb3\x61\x10\x8e\xf4\x9e\xea\xf3\x08\xca\x3c\xc4\x30\xae\xaa\x76\xcb\xf5\x2d\x9c\x84\x38\xd4\xa1\x05\x6d\xfa\x20\xf7\xef\x4b\xbe\xb5\xa0\x3a\x64\x06\x85\x5d\x5a\x33\x77\x97\x51\x9d\x10\xae\xec\x52\xdf\xea\xb3\x67\xbc\x49\x44\xcd\xc3\x46\xaf\xcd\x45\x29\x9d\xd5\xbf\x88\x52\x8e\x7a\xf1\x8d\xac\xe2\xc5\x5d\x6a\xdf\x86\x86\xac\x22\xce\xcd\xa9\x11\xd1\x7a\xf9\xb1\xbe\x7b\xda\x37\x71\x0d\xa5\x3d\x91\xc4\x02\x88\xed\xb0\x7b\x99\xc9\x5a\xa2\x73\x7b\xde\x29\x85\xc2\x36\x0a\x5e\xca\x17\xef\xe5\x13\x47\x6a\x93\x21\x01\x97\xc8\x7e\x6e\x38\x92\x21\x53\xc6\xc4\x34\xf6\x2d\xbc\x3f\x83\xde\x1b\x41\x5f\xa8\xd5\xba\x38\x92\x66\xc3\x13\x98\x4f\x1f\xcf\x36\x6e\x9c\xf3\xa1\x41\xde\x40\x32\x95\xc0\x77\x8e\x3e\xf6\xd5\x74\xb3\xe7\x7e\xa0\xe9\x76\x05\xd5\xc2\xd7\x8f\x03\x2c\xcd\x72\x29\x0c\x17\x1d\xf6\x04\xf4\xcf\xab\x32\x87\x67\x63\x45\xaf\xf9\xa7\x1e\xb3\x7a\x82\x87\x3b\xc2\x3e\xad\xff\xa7\xfa\x5e\xf7\x04\xfc\x41\x6c\xdd\xca\xee\xef\xc7\x4a\x3e\xd2\x6d\xf6\x82\xd6\xa9\xc1\x85\x36\xac\xae\x5f\xbb\x57\x32\x66\x64\xc3\x73\x56\xd7\xab\xe1\x2a\x0d\x78\x87\x2e\x43\xa9\xb5\x4c\xe0\x06\xb1\xa5\xd8\xb7\x79\xe0\x68\xdc\xc5\xdd\x4b\xb0\x0c\xa9\x92\x75\x9d\xb1\xfc\xc6\xe7\xc6\x64\x97\x88\x24\xba\x1c\x4e\xac\x50\x7f\x83\x8b\x21\xea\xd9\x86\x65\x32\x72\x94\x1d\xa2\x94\x83\x93\xa4\xb6\xaf\x14\x24\xed\x91\x6b\xaa\xb5\xde\x34\xeb\x97\x18\xff\x8c\x71\x85\x4d\x3b\x7d\x72\x7a\xc3\x95\x95\x65\xbb\xc0\xbd\x61\xfa\x35\x9d\x00\xcd\xef\x85\xa9\xc0\xdb\xcf\xe1\xb7\x36\x73\x0f\x35\x52\xa7\x17\xd8\xc8\x25\x46\xa6\x69\x6d\x2e\xf5\x6f\x3d\x9f\xfb\xfb\x27\x2d\xfc\xa9\xb8\xc1\x88\x9c\xb0\x7d\x6b\xa0\xf5\xe1\x95\x67\xbc\x03\x2f\x47\x12\x3c\xc5\xab\x47\x34\x1a\xe8\xa5\x4e\xcf\xaa\x46\x16\x23\x9d\x7c\xb2\xff\x26\x0b\x8c\xe2\xf4\x23\xaa\x26\x8a\x1f\x13\x16\xcc\x86\x33\x9a\xdb\xd0\xd8\x83\x70\x38\xed\x70\xba\x97\x77\x40\xbf\x3a\x15\x4b\x65\x51\xea\xf4\x17\xfd\x5e\x9a\xf3\x3b\xae\x4d\x84\x4a\xc5\x8f\xa9\xfe\x8e\x8b\x1b\x3a\x89\x04\x1e\x96\xf8\x65\x74\xa7\x85\x5c\xb6\x2b\x7b\xbe\x13\x86\xdd\xac\x1d\x14\xf0\x5d\xc7\xc8\x20\x41\x4e\x1b\x7b\xcf\x45\x9f\x67\xf8\x1a\xf4\x8d\x94\xdf\x5c\xab\x3c\x81\x42\x9b\x21\x9e\x1b\x59\x20\x49\xa2\x55\xd2\x22\x76\xd1\xed\x82\x7b\x1c\xda\x1f\x5a\x14\xc4\xfe\x48\xb4\x6d\x3c\x0d\x72\xb1\x8e\x16\xd9\x99\x4d\x61\x56\x9f\x82\x92\x9f\x26\x3e\xff\x79\xf1\xe1\xfd\xbb\x7f\xde\xdb\xdf\x67\x17\xe7\xa7\x57\xe7\xee\xf7\xf9\x3f\xce\xde\x39\x35\x77\xd9\x79\x1d\xce\x36\xb3\xd2\x33\xd9\xae\x22\xbb\x39\x17\xdb\x7e\x95\x9d\xd9\x0a\xe8\x4d\x84\x1a\xd3\x38\x84\xa2\x07\xa3\xdf\xed\x7b\x11\x3d\xff\x02\x6f\xda\x1a\x1b\x14\x46\x4f\xde\xf4\x3d\xd0\x4c\x89\xa3\x5b\x52\xca\xe6\x98\x4a\x60\xe3\x2d\x6c\xe4\xf8\x11\xaa\xd0\xb5\xe2\x75\x5f\xa4\xa3\x9d\x7c\x50\xdc\x4d\xb8\x1f\x78\x7f\xdb\x45\x8c\x1a\x84\x6c\x36\x12\x2e\x68\x77\x3c\x0e\x35\xae\x32\x54\xff\xdf\xb6\x8a\x0b\x53\x46\xb7\x09\x84\xae\x01\xeb\x5a\x30\x92\x80\x17\xff\x25\xc2\x64\x54\x94\xe3\x49\xf1\x70\xd9\xee\x40\xff\xd1\x37\xad\xd4\xbf\x65\x7d\x53\x3d\xdd\x35\x24\xc5\x7d\xb6\x49\x2f\xbb\xe6\xf9\xf1\x4b\x87\x7d\x96\xd9\x7e\xfc\x89\x6a\x14\x6e\x2e\x26\x49\x2a\xb5\x1f\x87\xee\xef\xa1\xc2\xbb\xd4\xbe\x76\xe1\x95\xec\x9f\xb9\xba\xe6\xfa\xe4\x53\x4f\xe7\x3e\x12\x8d\xb6\x7d\xa8\xfb\xa1\x87\x6e\x7b\x69\x9c\x3e\x17\xb9\xae\x28\x24\x63\x26\x0d\xa7\x05\x0f\x6f\xfc\xf4\x33\xd1\x4e\xa1\x31\xb0\xcf\xd7\xdf\x30\xce\x97\xac\xbe\x5c\x35\x35\x17\x37\xfa\x1b\xb5\x6b\x1d\x1b\x03\x7c\xf5\xa9\xb6\x59\x4f\x5d\x21\xdd\x45\xd6\x66\x4c\xb8\xf4\x29\xa8\x39\xb7\x1f\xa5\x9e\x6a\x8a\x0e\x6a\x0c\x5b\x85\x4b\x2e\x3b\xdd\x97\xf6\x1b\x6c\x0d\x30\x0d\x4f\xb5\x8d\x1b\xbb\xeb\xb8\xfd\x53\xeb\x9e\x6f\x00\xe8\x60\x1c\x50\x5f\x83\xff\x0e\x00\x7a\xfb\xe4\x20\x01\x1f\x00\x00")
+
+func selfupdate_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_selfupdate_go,
+		"selfupdate.go",
+	)
+}
+
+func selfupdate_go() (*asset, error) {
+	bytes, err := selfupdate_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "selfupdate.go", size: 7937, mode: os.FileMode(420), modTime: time.Unix(1792412722, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _shebang_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x51\xc1\x6e\xd4\x30\x14\x3c\xc7\x5f\x31\xf4\x94\x48\x69\xca\x19\x94\x43\x11\x20\x71\x41\x48\x1c\x11\x42\xaf\xf6\x4b\x62\x75\xed\x44\xcf\xce\x6e\xab\x76\xff\x1d\x39\xce\x42\x76\x39\x2c\x07\x1f\xec\xf1\xcc\x9b\x99\x37\x91\x7e\xa4\x9e\xe1\xc8\x7a\xa5\xee\xee\x60\x7d\x64\x99\x84\x23\xcb\xbd\xf4\x7b\x08\xc7\x59\x7c\xc0\x61\xa0\x88\x38\x82\x9f\x58\xa3\x1b\x05\x71\x60\x04\x2d\x76\x8a\xa0\x08\xed\xcc\x37\x8a\x43\x83\xef\xcb\x53\x48\x52\x0f\xb3\xdd\x45\x1c\x6c\x1c\x70\xfb\x30\x7b\xb3\x63\x73\xbb\x91\x0f\x90\xd9\x63\xf6\x86\xb3\xd8\x06\x82\xb0\x1e\xc5\xb0\x81\xf5\x49\x29\xc1\x8e\xbc\xed\x38\xc4\x1a\x87\xc1\xea\x01\x36\x40\x0f\xac\x1f\xd9\x64\x2b\xe4\x18\x07\x7a\x06\x05\x10\xf4\xe8\x1c\x79\xd3\xa8\x6e\xf6\xfa\x32\x53\xb9\x9a\x45\x88\x62\x7d\x5f\x83\xa4\x0f\xf8\xf1\x33\x5f\x2b\x94\x24\xfd\xdb\x2d\xb8\xff\x03\xd6\x60\x91\x74\x46\xa9\xf0\xa2\x0a\x4f\x8e\x6b\xfc\xca\xcf\xef\x5a\xe4\x98\x5f\xc9\xf1\x69\x48\xa5\x0a\xdb\x2d\xf0\x9b\x16\xde\xee\x12\xab\xc8\xa5\xaa\xe2\xa8\x0a\x4e\xb4\x53\xb6\xcf\x76\xc7\x65\xd2\x5c\x59\x68\x33\xe7\xf5\x15\xdc\x7c\xd9\xf4\xd3\xb6\xb8\xb9\xd9\x48\x9d\xfa\xaf\x41\xd3\xc4\xde\x94\x27\xc3\x2f\x2b\x70\xcc\x29\x9b\xa6\xa9\xea\x24\x99\x66\xab\x22\x05\xcd\xde\x5b\x50\x08\x1c\xd3\xd7\xf2\x03\x05\xfe\x68\xa5\x3e\x9f\x79\x2d\xc8\x0a\xb6\x79\x2b\x9f\x9e\x58\x2f\x62\x69\x44\xf5\xfe\x7f\x78\x7b\x16\xdb\x3d\x27\xe2\x52\xc3\x55\xe2\xb2\x98\xf6\x9f\xc0\x89\x77\xbc\xf0\x7e\xbf\x46\xbf\x24\xa5\x5b\x8d\xbf\x9b\x5a\xcb\xcc\xb5\x9c\xfd\x39\x2f\xef\xa8\x7e\x0f\x00\x5b\xf8\xa6\x32\x38\x03\x00\x00")
+
+func shebang_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_shebang_go,
+		"shebang.go",
+	)
+}
+
+func shebang_go() (*asset, error) {
+	bytes, err := shebang_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "shebang.go", size: 824, mode: os.FileMode(420), modTime: time.Unix(1792408649, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _shell_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc4\x57\x5f\x6f\xdb\x36\x10\x7f\xb6\x3e\xc5\x95\x08\x3a\x09\x55\xe4\xf6\x65\x03\x12\xf8\xa1\x69\xd2\x25\x43\xd3\x04\x71\xd6\x0e\x0b\x82\x94\x96\x4e\x16\x61\x99\x14\x48\xca\x6e\x52\x64\x9f\x7d\x38\x92\x92\xe5\xfc\x69\xbb\xa7\x01\x41\x40\xf3\x8e\xf7\xe7\x77\x3f\xde\x51\x0d\xcf\x17\x7c\x8e\xb0\xe4\x42\x46\x91\x58\x36\x4a\x5b\x88\xa3\x11\x13\x6a\x2c\x54\x6b\x45\xcd\xa2\x11\x53\xc6\xff\x1f\xe3\x57\xcc\x69\xd9\x70\x5b\x8d\x4b\x51\x23\x2d\x68\xc3\x58\x2d\xe4\xdc\xb0\x28\x89\xa2\xf1\x18\x76\x4d\x85\x75\x0d\xc6\x72\x6d\x0d\x70\x09\x42\x5a\xd4\x3c\xb7\x62\x85\xe0\x65\x42\xc2\xba\x12\x79\x05\xb6\x42\x98\xb5\xb2\xa8\xb1\x80\x5c\x2d\x97\x5c\x16\x86\x16\x48\x86\x4a\xa1\x8d\x25\xdd\xf3\xb7\x97\xc7\x29\xd4\x62\x81\x64\xce\x59\xe2\x16\x0b\x58\x09\x6d\x5b\x5e\xa3\x5c\x65\x70\x59\x75\xc6\x35\x36\x35\xcf\xd1\x90\x09\xd3\x9a\x4a\xcc\xd4\xd7\x14\x8c\x82\x1a\xf9\x4a\xc8\x39\x08\x0b\x1a\x6d\xab\xa5\x01\xab\x5c\x08\x39\xaf\x6b\xd4\xbf\x18\x68\xe5\x52\x15\xa2\x14\x58\x00\xca\x95\xd0\x4a\x2e\x51\xda\x2c\xca\x95\x34\x0e\x1b\xe7\xe2\x48\xae\x00\x60\x02\x6c\xfa\xe7\xf4\xf8\xe4\xe0\xec\xaf\x9b\xe9\xf1\xd1\x87\x0f\x2c\x1a\x35\x5a\x2d\x1b\xeb\xe4\x43\xf1\xf9\xc5\xd9\xe9\xf9\x25\x8b\x46\x2b\xd4\x46\x28\x49\x0a\x43\xf9\xa7\xa3\x8b\xe9\xc9\xd9\x47\x16\x8d\xee\x0a\x65\x0b\xa1\x1f\x2a\xfc\x7d\x78\x76\x79\x78\x72\xd1\x41\x3c\x25\x6c\xdb\x06\xa8\x0a\x26\x40\xa9\x91\x17\x2e\x99\xd6\xb8\x54\xd4\x5a\x82\x92\x48\x15\x70\xfb\x12\x9a\xd6\x3a\x05\x1f\x24\xd9\x59\x72\xbd\x40\x4d\x10\x97\x5a\x49\x0b\xaa\x1c\x28\xa4\xc0\x8d\x87\xd4\x80\x41\x4b\xb0\x95\x5a\x2d\x49\x43\x68\xd0\xb9\xf7\x9e\x91\x9d\xcb\x4a\x18\x10\xc6\x1d\x56\xb2\xbe\x05\x57\x01\xf7\xb3\x73\x61\x80\x17\x05\x16\xfb\xa0\x6c\x85\xba\xb3\x3b\xf7\x76\x85\x74\x59\x6d\xc3\xe5\x2c\x75\xd0\xcf\xb8\xa9\x2e\xde\xc1\x04\xbe\x5c\xc1\x6e\x09\xff\x8c\x33\xda\xd1\x39\x5c\xc3\xcb\x97\x90\x6d\x36\xa2\xf3\xe9\x9b\x09\xdb\x79\x60\x6b\xe7\x7c\xfa\x86\x45\x5f\x1c\x78\x77\xa6\x22\x78\x09\x2f\x33\x04\x2c\xbb\x33\x15\xca\x15\xac\x85\xad\x42\x92\x01\xf6\x0c\x3e\x13\x7e\xb6\xe2\x16\x96\x6a\xe5\xa9\x15\x64\x29\xd9\x83\xb5\x6a\xeb\x62\x53\x02\x32\xa5\x73\x5a\x6a\x04\x21\x8d\x25\x41\x00\x57\x49\x84\x19\xd6\x6a\x4d\x9c\x24\x43\xb4\x29\x71\xdd\x19\x24\xa8\x16\xd8\x58\x28\x95\x1e\x1a\x73\x67\x88\xb0\xce\x0b\x55\xa2\xc3\x26\x24\x34\x81\x2f\x37\x1d\xdf\x6f\x02\x8f\x26\x6c\xa7\xa7\x8e\x28\xe1\x0a\x76\x25\x0c\xc0\xe9\x64\x70\xbd\xef\x29\x12\x36\x26\x4f\xe8\xec\x03\xd6\x06\xa1\x95\xc4\x85\xb0\xb9\x0f\xa5\x88\x5c\x41\xd8\xce\xb7\xb0\xb7\xb7\xbb\x73\x7c\x76\x7a\x74\x3f\x0e\x78\xb2\xae\x44\xdf\x51\x89\x1e\x7a\x1b\xc6\xbd\xd9\x7a\x94\x1e\x8b\x7c\x38\x8f\x04\x54\xea\x1e\x1c\xcf\x9b\xff\x23\x7d\x9d\xff\x28\x7b\x9d\xb3\xc8\x53\xf4\x29\xd2\x86\xb6\xe1\x79\xeb\xae\xcc\x39\xb7\x55\xdf\xbd\xb6\xdb\x4f\xea\x23\x1c\x30\xda\x6d\x67\x51\xd9\xca\x7c\x73\x3a\x4e\xc0\x37\x6c\xf8\x16\x8d\x88\x63\x37\x29\x2c\xf0\x16\xf6\x26\xa0\xb9\x9c\x23\x5c\x5d\x7b\xf9\xb7\xae\xd7\xa5\xc0\x7c\x7f\xbb\xa7\x23\x23\x51\x82\xa9\x48\x5d\x99\xec\x77\xb4\x28\x57\xf1\x02\x6f\x93\x7d\xda\x7d\x31\x01\xc6\x9c\xd6\xc8\x07\x09\xa6\x8a\x46\xa3\xfb\x88\xfe\xc2\x0e\x1b\xcf\x84\x1c\x9b\x8a\x45\xf7\x83\xbc\x5c\xcb\xe9\x7a\x48\x68\x1a\xd4\xb0\x9e\xee\x4d\x5b\x49\xb9\x9d\xad\xb4\x24\x5f\x22\x45\xc8\x3a\x5e\xb0\x88\xc2\x16\xb2\x54\x29\xa0\xd6\x24\xab\x15\x2f\x0e\x5a\x51\x17\x27\xb2\x54\x71\xb2\xef\xf6\x27\x13\x90\xa2\xa6\x8a\x91\x6e\xf6\x91\xec\x6c\x72\x72\x66\x27\x1b\xd1\x56\x56\x31\x83\x57\xe0\x34\x5e\x01\x4b\x60\x2b\xbb\x23\x3f\x4b\xfa\xc2\xa1\x5c\xad\x5c\x77\x45\x0b\x6d\xd3\xdf\x74\x3f\x09\xf7\xdc\x7a\xc5\xb5\xe0\xb3\xda\xf7\x9a\x7e\x34\xce\xd1\xa6\xd0\xd4\xad\x79\x7a\x72\x86\x59\x39\x44\x27\xb8\x8e\x9d\xcb\xae\xb4\x49\xbf\xa2\xbc\x9c\x68\x02\x4b\x4e\x73\x29\xae\xc5\x4c\x73\x7d\x4b\x4b\x12\x24\x49\xaf\xd0\x68\x6c\x50\x16\x44\xa2\x4e\x9a\x02\x23\x87\x2c\x85\x03\x21\x0f\x85\xde\x28\x1b\xb4\x1b\x25\xad\x94\x75\x44\x3a\xe0\x06\x9f\x57\xdb\x4c\xc7\x14\x3e\xf9\x75\xd2\x03\xbc\xa5\xd9\xcf\xd9\x74\x9b\x03\x49\x40\x7d\xad\x85\xc5\x29\x49\xde\x8b\x1a\xfd\x4f\x03\xdc\x3f\x4a\xc2\xe0\x84\x56\x16\xa8\x61\x4a\x14\x39\x50\x5f\x0f\x85\x1e\x3b\x5b\x01\xbd\x6d\x13\x31\x55\x36\x85\x5c\x49\x8b\xd2\x42\x07\x63\xec\x17\x8e\x54\x4a\x27\x84\x66\x21\x1c\xbd\xba\x07\x52\xf6\x87\x12\x32\x1e\x38\x49\x81\x39\x37\x2c\x71\x9c\x0c\x6c\x54\x26\x3b\x5d\x14\x42\xbf\xad\xeb\xb8\x20\x25\x65\x32\xf2\x7b\xaa\x0a\x8c\x5f\xff\xf6\xfa\x75\x12\x18\xfa\xc2\x33\x94\xe8\xd8\x31\x8f\x39\xf7\x8e\x8c\xcd\x63\xd7\xce\x1a\x45\xbf\xe5\xcf\xbf\xef\xb2\xcf\x94\xa4\xcb\xaf\x49\xe1\xea\x7a\x76\x6b\x31\x0e\x39\x26\x0f\x62\xf8\xf5\x67\x63\x08\x5b\x4d\x4a\x4a\xc3\x5b\xf0\x56\xcf\x57\xfd\x15\xa8\xfc\x30\x73\x05\x01\x53\x81\x51\x7e\xbe\x0a\xfa\xa5\xd6\x66\x70\xd9\x43\x3b\x18\xb2\x9a\x4c\xc5\x74\xaa\x83\x7f\x9b\xdd\x71\xb7\x4c\xe1\xea\xfa\x71\x89\xcc\x5a\xd8\xbc\xda\xe0\x44\xac\x8c\x4d\xe5\x64\x39\x37\x08\x8c\xde\x12\x6c\x8f\xf2\xcb\xfb\x86\xf1\x80\x10\xcc\xbf\x37\x58\x1a\xde\x26\x89\x6f\x8d\x0f\xf0\xe9\xd0\x90\xa2\x4e\xfd\x3f\x07\x13\xe1\xd4\x89\x06\xfd\x36\x05\xb6\xbb\xab\x73\x0a\x8c\xa5\x40\xbe\xd9\xae\x60\xf7\x3e\x3f\x8f\x67\x08\xf0\x2e\xc4\x27\x4a\xea\xdf\xcf\x44\xd8\x8d\xd7\x34\x3c\x11\x1e\x97\xef\x7b\xe1\xfd\xd0\xb4\x4b\xde\xcd\xd7\xff\x66\xf8\xa9\xab\xbf\x79\xf7\xa6\x83\xb9\xc2\xba\xe9\x9b\x24\xcf\x9c\xeb\x35\xd2\x9f\xb9\x71\xc9\xb3\xa8\x3f\x44\xf9\x3e\xfa\x39\x45\x4f\x6f\xdd\xca\xe9\xf0\xa3\xc7\x56\x83\x2f\x1d\xff\x16\x56\x65\xff\x31\x12\x88\xdc\x1d\x8a\x5d\x1b\x29\x54\x6b\x53\x30\xb6\x20\x20\xfd\x4d\xf4\xe0\x0f\x68\xeb\xa6\xee\x60\x90\xbb\x0b\xfd\xc2\x87\x67\xb2\x77\x4a\x5a\x2e\xa4\x89\x5d\xa0\x63\xe6\xce\x8c\xc6\x63\xf8\xa0\xd4\x62\x10\x52\xdb\xc0\x0c\x4b\xa5\xd1\xcd\x0a\x10\x06\xf2\x8a\x06\x7f\x91\x75\xc3\xdd\x3b\x9e\x00\x7d\xf1\x65\x74\xda\xb9\x33\xd5\xb3\x65\xee\x47\x3c\xd7\xf3\x55\x07\x4f\x60\xce\xf0\xbe\xa6\xdb\x33\x49\x99\xac\x5b\x26\xc9\xa6\x3d\x3d\x6a\x2f\xce\x74\xad\xe6\x73\xd4\xd9\x21\xce\xda\x79\x1c\x0a\x9a\x82\xfb\x1a\x65\xa9\x0b\x9a\x91\x77\x96\x82\x0f\x82\xee\x27\xdd\xa2\xcd\xc8\x09\x05\xa5\xac\xde\xb7\x32\x77\xf1\x6c\x02\xa6\xd1\xf1\xef\x00\x5f\x2d\xc8\x3f\x12\x0f\x00\x00")
+
+func shell_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_shell_go,
+		"shell.go",
+	)
+}
+
+func shell_go() (*asset, error) {
+	bytes, err := shell_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "shell.go", size: 3858, mode: os.FileMode(420), modTime: time.Unix(1792411908, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _supervise_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x57\x6d\x6f\xdb\x38\x12\xfe\x6c\xfd\x8a\xd9\x00\x1b\x48\x77\x8a\x9a\x76\xef\xc3\x22\xb7\x3e\xa0\xc8\xb9\xdd\xe0\x6e\x13\x23\x76\x51\x1c\x8a\xa2\x60\xc4\x91\x4c\x44\x26\x05\x72\x94\xc4\x48\xfc\xdf\x0f\x43\x52\x96\x1c\xfb\xba\xbd\x02\x8d\x25\x72\x66\x38\x2f\x0f\x9f\x19\xb5\xa2\xbc\x17\x35\xc2\x5a\x28\x9d\x24\x6a\xdd\x1a\x4b\x90\x26\x93\x93\x6a\x4d\x27\xc9\xe4\xc4\xb8\xf0\xf7\x0d\x3e\x61\x19\x1f\x9d\xaa\xb5\x68\xf8\xc5\x6d\x5c\x29\x1a\xff\xd8\x69\x27\x2a\x3c\x49\xb2\x24\x29\x8d\x76\xde\xc8\xda\x48\x9c\x3d\x61\x09\xfe\xdf\x14\x4e\xa2\x11\x5e\x5f\x74\x2d\xda\x07\xe5\x90\xd7\x5d\xff\xe2\xf5\xdf\xbc\x81\xca\xd8\x47\x61\x25\xca\x85\x3f\xcb\x81\xb0\x08\xad\x70\x0e\x25\x18\x0d\x64\x40\xc0\x4e\x49\x42\x69\xd6\x6b\xa1\x65\x91\x3c\x08\x7b\xa8\x3b\x85\x2f\x5f\x8d\x2b\xc2\xeb\x73\xf4\xb9\x58\x5c\x7d\xbc\xba\x5e\xe6\x30\x7a\x5f\xce\x6e\xff\xd8\x5b\xf8\xfd\xd3\x7c\xef\xfd\xf3\xd5\xf5\xe5\xef\x5b\xef\x61\xb9\x52\x8d\x9c\x3d\x29\x02\xe5\xc0\x22\x75\x56\xa3\x84\xc7\x15\xea\xa3\xae\x81\x54\x12\xb4\x21\x40\x56\x79\x54\xb4\x82\xf3\x22\xa1\x4d\x8b\x23\x4b\x8e\x6c\x57\x12\x3c\x27\x13\x47\x82\x3a\xb7\x3b\xfa\xb3\x50\xb4\xf0\x4b\xc9\x36\x49\xaa\x4e\x97\x90\x22\xfc\x65\xa7\x99\xc1\xcc\x5a\x63\xd3\x8c\x4d\x28\x5d\xb3\x09\x55\x01\x16\xc1\x4e\x0c\x1d\x65\x9a\xf1\xce\x24\xb8\x0b\xd5\x9a\x8a\x45\x6b\x95\xa6\x2a\x3d\xe9\xfd\xbc\x57\x4d\x83\x12\xee\x36\xf0\xb3\x3b\xc9\x5f\x9b\x48\xb3\x2c\x99\x6c\x93\xef\x5a\xe0\x08\x51\x86\x18\x7f\x96\x63\x1b\xb3\xa7\x3e\x0c\xb6\x13\xf3\x68\x24\x82\x72\x40\x2b\xf4\x9a\x10\x43\x37\x95\x5f\xea\x8d\x0a\xc7\x69\x5d\x61\xd3\x80\x45\x06\xa9\x03\x45\xc5\xd1\x54\xb0\xc5\x34\x03\xa5\xe9\xc7\xd2\xf0\xf6\xdd\xaf\xf0\x57\x16\x4f\xbf\x1f\xec\xd1\x30\x62\x14\xad\x35\xad\xa8\x05\x21\xa0\x96\x0e\x5c\xe7\x56\xea\xce\x3c\xf9\x10\x1e\xc5\x66\x2f\x14\xd4\x12\xe5\x05\xdc\x85\x55\x27\xd6\x08\xe1\x46\xe5\x6c\xc9\xd8\x90\xb9\xdd\xde\x28\x29\xc7\xe3\xdd\x9d\xfd\x43\x41\x3b\x55\xc3\xc5\xf4\xb0\xae\x61\x4b\x8b\xa6\xb8\x45\x87\x94\x3a\x55\xfb\xb5\x88\xc0\x7f\xa9\xa6\x49\x8d\x2b\x3e\x22\xb5\x4a\xa6\x59\x0e\x41\x60\x9c\x9e\x90\xf8\x98\x91\xdd\x15\xf8\xc0\x2e\xdb\x4e\xbb\xc3\x7a\xfa\x20\x40\x69\x47\x28\x24\x57\xdc\x62\xdb\x88\x52\xe9\x3a\x58\x08\x49\x2c\x60\xb9\x8a\xb7\x04\x6a\x24\x56\x6c\xad\x29\xd1\x39\xa8\xad\xe9\x5a\x56\x54\xe4\xc0\x3c\xea\x1c\x1e\x57\xaa\x5c\x81\x72\xd0\x76\x04\x4a\xb3\x1d\x3e\xb6\x32\x16\x59\x58\xcb\x1e\x58\x84\x76\xad\x38\xe9\xe0\x0c\xd0\x4a\x50\x2c\x82\x83\xca\x9a\xf5\x9e\x08\x58\x14\xe5\xaa\x37\x15\x1c\x11\x8d\xd1\x08\x1c\x89\xd1\xe8\xc0\xa1\x26\x20\x33\x14\x5e\x58\x1c\x88\x88\x77\x14\x81\xd1\x25\x06\x8a\xda\x4f\xce\x14\xb8\xac\xa9\xb0\xf5\x79\xbc\xbf\x39\x08\x5b\x3f\xe4\x80\xfa\xe1\x01\xbe\x7c\x0d\x8b\x19\xa4\x8e\xa4\xe9\x28\x07\x47\x12\xad\x85\x2f\x5f\xef\x36\x84\x39\xf0\x33\x32\x01\xf8\x12\x97\x6b\xc9\x15\x3e\x65\xb2\x2d\x2e\xd7\xf2\x79\x2e\x68\x75\xc1\x16\xcf\x73\x78\x6f\x6b\x77\x11\xad\xcf\xf4\xc3\x85\x3f\x22\x87\x05\x49\xa5\x2f\x80\x59\x92\x9f\xfc\x82\xe9\xa8\x5f\xf1\x87\x2e\xfc\xa1\xfd\x12\x5a\xbb\xf5\x67\x15\x8b\x8d\x9b\x5b\x53\xbe\x27\xb2\x30\x85\xd3\x1d\x5d\x0e\xcb\xcf\x0b\xa4\xb6\x56\xf2\x02\xc8\x76\xb8\x4d\x26\x44\x1b\x76\x71\xa8\xca\x32\xa6\x9a\x71\xa8\x2a\xe0\xfd\x7f\x4c\xe1\xdc\x23\xf6\xd5\x19\xc5\x87\x9d\x16\x4c\xbd\xc1\x23\x32\x97\x6c\x61\xca\x76\x18\xa2\x09\x43\xdb\xf1\x89\x6b\x71\x8f\x69\xb9\x12\x1a\x76\x0d\x21\x87\x5f\xb3\xa4\xc7\xfe\xb5\x21\x55\x6d\x18\xfc\x2e\x3f\x68\x24\x45\x51\x64\xc9\x44\x62\x85\x36\x82\xa5\x58\x90\x69\xbd\x74\xf0\x9b\x0b\x31\x05\xef\x0c\x09\x4b\x69\xf6\x77\xbf\xf4\xd3\x14\xb4\x6a\x46\x9c\xe3\xef\x4d\xab\x7c\x9d\x58\x7a\x1e\xf0\x5c\xcc\x95\x4c\x26\x92\x91\xb5\xe7\x6b\xe8\x0b\xcf\xdb\xdd\xe9\x65\x63\x1c\xa6\x2c\x98\x25\x93\xda\x04\xfc\x84\xfb\x5d\x19\xeb\x7f\x27\x0e\x1b\x0c\xcd\x64\x32\x29\x85\xf3\x24\xc3\x66\x7f\x3b\x63\x7f\x2f\x78\x79\xff\x76\x9f\xb5\x4a\xfa\x6b\x5d\xa4\xbb\x1a\xfa\x28\xb3\x6c\x67\xe3\xb7\x33\x3e\x34\x28\xf7\xa1\x4c\x38\x18\xfe\xbf\x4d\xb3\xa4\x6f\x5d\x01\x94\x17\x53\x78\x14\x8a\x2e\xf9\xca\xa4\xde\x3c\xd1\x26\x4b\x26\xe3\x98\x6f\xb1\x41\xe1\x30\x1d\x65\x30\xa4\xeb\xf4\x14\xd2\x60\x6c\xcc\x61\x2f\x2f\x70\x84\x86\x39\xc5\xe7\x21\x01\xa1\x06\xa7\x3b\x82\x7c\x0e\xe2\xdb\x11\x57\x45\x8a\xda\xb9\xe6\x9f\x1c\xd7\x7b\x8f\xa3\xc8\x04\xf2\xf5\xcf\xe2\x1e\xdd\x3e\x2b\xdc\x89\xf2\xbe\x60\x3b\x9f\xb9\xe9\x8f\x15\x95\x03\x47\xa6\x6d\x51\x1e\x92\x49\x3e\x70\x04\xcb\x38\x20\x63\xc0\x99\xc0\x2e\x82\xbc\x6c\xdf\xe7\x6a\xa1\xb4\x7b\xa5\xcd\xf6\x4b\xa3\x49\xe9\x0e\xf7\x29\xd5\xcf\x1e\x8a\xd8\x92\x72\x3b\x19\x09\x8a\x1c\x36\x55\xec\x1d\x87\xe5\x00\xa5\x29\x83\x5d\xc9\x87\x51\x23\x1f\x71\x8a\x69\x49\x19\xed\xaf\xd0\xf9\xe1\x0d\xed\x77\xa7\xc3\xc0\xf2\xe9\x7a\x79\xfb\xfe\x72\xf6\x4f\x9f\xf5\x1e\x93\x9e\xfa\xfe\xe7\x64\x33\x99\x7c\xdb\xa1\x66\xbc\xfd\xb7\xe0\xea\x69\x0f\xac\x78\x5a\xce\x20\x61\x64\x0e\xa8\xe9\xb5\x66\x57\xd7\xcb\xdb\x88\xfc\x98\x86\x80\xd0\x5e\x76\x74\x21\xfb\xf6\x35\x82\xed\x20\xbb\x17\xe5\xc4\x21\x0d\xdc\x93\x12\x6d\x86\xd9\x90\xdb\x62\x6d\x5b\x3f\x31\xf4\xca\x3f\xf5\xe0\x0d\x50\x48\xb3\xa3\xe7\x69\xd5\x44\x95\xef\xb4\xda\x61\x02\x5d\x2c\x6f\xe6\x59\x72\xd4\x97\x56\xc9\x2c\x39\x7e\xa7\x07\xfd\xcb\x9b\xeb\xa5\x6f\xdb\xdb\x7e\xce\x7e\xc5\xc0\x71\x96\x0d\xd0\x92\xe8\x4a\xab\x5a\x32\xf6\x75\xdb\x04\xc3\x21\x48\xa5\x3d\xec\xc6\xed\x1a\x94\x03\xa5\x21\x5e\xa8\x68\x3d\x07\x63\xe1\xec\x6d\x04\xe1\x31\xde\xef\x47\x97\xca\x93\x22\x4f\x63\x7d\x33\x2a\x3e\x48\x9f\x57\x46\x0f\x27\x99\x37\x7f\x79\xe7\x51\xf8\x2d\x87\x80\x19\x6d\xc6\xa8\x59\x84\xdf\x81\xc6\xfe\xb3\xf8\x76\x75\x73\xb9\xfc\x77\x0e\x9d\xd2\xd4\x92\x4d\x2b\x39\xca\xeb\xf2\xea\xe6\xf2\xe3\xfc\xe3\xed\x7c\xd8\x0f\x9f\x33\xc5\xdc\x28\x4d\x68\xd3\x53\x3e\x38\xcb\x02\x9f\x6b\xe3\xe9\x66\x3c\x43\x9e\xbd\xf5\x40\x57\x95\xf7\xdc\x0b\xb3\xcc\x01\x3c\x8e\xe8\xc4\xb7\x4a\xf6\x83\xd3\xb8\xb2\x3c\xc6\xb8\x3e\xea\x3f\x99\x64\x8a\xa1\x04\x6b\xb1\x61\x53\x77\xd8\x6b\x31\x57\x45\xad\x48\x13\x20\x0d\x3a\x70\xa6\x1f\x99\x1e\x4d\xd7\x48\x4f\x49\xbc\x79\xb7\x01\xfe\x1e\x5a\xde\x7c\x8a\x25\x3b\x86\xb7\x58\x0b\x1f\x54\xec\x87\x57\xb5\x36\x16\xd3\xf1\x37\xd5\xf2\xe6\xd3\xeb\xae\x19\x27\xcc\x03\xa1\x36\x96\xfe\x97\x77\x21\x85\xc9\xe4\xff\xa8\x28\x77\x96\xfd\x92\x2e\xfe\xa4\xa4\xd9\xee\x23\xc4\x76\x3a\x8c\xa9\x11\xfb\x2b\xf3\x08\x62\x20\xd6\x7e\x1e\xaf\xd5\x03\x6a\x4e\x05\x29\x5d\x3b\xff\xe1\xd7\xe9\x02\xe6\xc6\xd1\x19\x4f\x5b\x6c\x6a\x65\xcc\xbd\x0b\x2d\x63\x85\x20\x3a\xa9\x08\x1a\x53\x83\x46\x94\x43\x81\xc8\x78\x26\x7e\xdd\x72\x62\xb2\xa3\x3b\x69\xd9\xaf\x5f\x1a\x5d\xa9\x3a\xfb\xe1\x39\xb1\x1f\x0c\x47\x03\x62\x24\x72\x55\x41\x59\xfc\x61\x24\x32\x5f\xee\x7f\x87\xbf\xbc\x78\xe7\x67\x4f\xca\x91\x4b\xf9\x91\x03\xe3\xef\x77\xdf\x75\x7d\x28\x33\x2d\xee\x0e\x3e\xa0\xf6\xa6\xd9\xbd\x4f\x82\x27\x2c\xfd\xda\x36\xf9\xef\x00\x54\xde\x49\x95\x68\x10\x00\x00")
+
+func supervise_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_supervise_go,
+		"supervise.go",
+	)
+}
+
+func supervise_go() (*asset, error) {
+	bytes, err := supervise_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "supervise.go", size: 4200, mode: os.FileMode(420), modTime: time.Unix(1792411631, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _sushibox_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa4\x5a\x6d\x6f\xdc\x36\x12\xfe\xbc\xfa\x15\x53\xe1\x5a\x48\x89\x22\xbb\x9f\x0e\x48\xb1\x07\x38\x8d\x73\x69\xaf\x49\x8d\x3a\xbd\xfb\xd0\x06\x01\x57\xa2\x56\xac\x25\x52\x20\xa9\xb5\xdd\x26\xff\xfd\x30\x7c\x11\x29\xed\x4b\x37\x2d\xd0\xd6\x2b\x8a\x9c\x79\x66\x86\x9a\x79\x86\xec\x40\xaa\x3b\xb2\xa5\xd0\x13\xc6\x93\x84\xf5\x83\x90\x1a\xb2\x64\x95\x36\x1d\xd9\xa6\xf8\xb7\xd7\xf8\x67\xcb\x74\x3b\x6e\xca\x4a\xf4\x17\x3d\xd3\x55\x4b\xbb\xae\xbd\xd8\x8a\x67\xad\xe8\x69\xcd\x24\x4e\x61\xe2\x82\x89\x51\xb3\x0e\x1f\x84\xc2\xff\x0e\x44\xb7\x17\x0d\xeb\x28\xfe\xc0\x01\xf5\xa8\x2a\xd2\x99\x19\x9a\xf5\x34\x4d\xf2\x24\xd9\x11\x09\xaf\x45\x4f\x5f\x32\x09\x6b\x68\xed\xaf\x2c\x37\xe3\xb7\xa3\x6a\xd9\x0b\xf1\x80\xef\x94\x96\x8c\x6f\xcd\xf0\x7f\xa9\x54\x4c\x70\xb5\x18\x7e\x41\x14\x5d\x0e\x31\x1e\x8d\x24\xcd\xc8\x2b\x63\x6b\x96\xc3\x1f\xc9\x4a\xa8\xf2\xfa\x81\xe9\x4c\x52\xd2\xbd\x31\xa3\x79\xf2\xc9\xcd\x0a\x63\xc0\xb8\xc6\xd9\x55\x27\x14\x7d\x49\x37\xe3\xb6\x00\x2a\x25\x3c\x5f\x03\xe3\x4c\x9b\x91\x2c\x4f\x56\xac\x31\xc3\x5f\xac\x81\xb3\x0e\x17\xac\x2e\x2e\xe0\x0a\x36\xa4\x86\x1a\xe7\x80\xa2\x5a\x33\xbe\x85\x7e\x54\x1a\xb8\xd0\x70\x47\xe9\x00\xba\xa5\x50\x89\xbe\x27\xbc\x86\x46\x8a\x1e\xe4\xc8\x39\xe3\xdb\x32\x59\xad\x9a\x5e\x97\xaf\x06\xc9\xb8\x6e\x32\xa1\xca\x5b\x5d\x53\x29\x0b\x48\xff\x47\x24\x4e\x79\x1e\x00\x40\x43\x58\x47\x6b\xd8\x3c\xc2\x97\x4f\x77\x85\xd3\xd8\x89\xed\x16\x35\x32\x05\xa2\x69\x7e\xe5\xa9\x41\x9e\x27\xab\x4f\xc9\xaa\xa6\x0d\x95\x10\x8c\xca\xf2\x64\xb2\xc1\x9b\xc6\xa4\xca\xf2\x6f\x96\x66\x49\xaa\x47\xc9\x71\x54\x48\xe3\xbf\xd4\x4f\x9e\xa3\x88\xb5\x05\xc1\x9d\x20\xf5\x1b\xc2\x59\x43\x95\x3e\x4b\x78\xbc\xe0\xa8\x82\x64\x55\xf5\x75\x01\x44\x6e\xd5\x14\x9d\x81\x48\x45\xaf\xe4\x56\xb9\xe8\x3c\xd9\xd9\x7d\x03\x5f\x7d\x65\xa6\xac\xf7\xb4\x5e\xc6\x58\x4f\x82\x9a\x64\x9f\x34\xf9\x09\x19\x6b\x66\x36\x4f\xe4\x00\x13\xcf\x2b\x7c\xe1\x62\x2a\x46\x5d\x80\x81\xff\xe4\x37\x25\xf8\x8f\xa3\x1e\x46\xbd\xef\x99\x43\x28\x26\x51\x47\x60\x20\x8e\x3d\xe3\xbc\x1f\xd4\x21\x60\xfe\xdb\x8a\xb1\xfd\x25\x58\x5e\xd0\x67\x21\xab\x44\x3f\x74\x54\x63\x90\xbe\x58\x43\x9a\x2e\x10\xde\x4b\xa6\xe9\xb7\xd3\x9c\x19\xc6\xb0\xf4\x2c\x8c\x0b\x51\x9f\x85\x52\xd1\xae\xf9\x79\xa8\x89\xa6\x07\x51\x86\xd7\xaf\xa4\xe8\x67\x20\xc3\xab\xb3\x40\x46\x8a\x3e\x33\xbe\xac\x79\x5c\xa0\xb2\x83\x2f\x46\x5e\x77\x34\x3b\x4b\x7b\xbc\xe2\x94\x7e\xcc\x52\x37\x36\x49\xa5\x3f\xfe\xe7\x57\x9e\xe6\x0b\x50\xc9\x1e\x8c\x5b\xb6\xe5\x44\x8f\x92\x9e\x95\x01\x16\x6b\x4e\x24\x81\x81\x71\x4e\x6b\xd4\xd3\x90\x4e\x51\xa3\x78\x60\xdc\xec\x6e\xf3\xca\xed\x4a\x54\x8b\xe3\xb3\xf0\xd9\x19\x85\xcd\x0d\x30\x2a\x7a\x33\x5b\x32\xb0\xf3\x76\xd6\x72\xe1\x09\xd7\x7d\x4a\x82\x5e\x5f\x31\xde\xb5\x14\x06\xf2\x88\x59\x0f\x44\x03\x84\x03\x25\xb2\x63\x54\xc2\x66\x64\x5d\x0d\x4c\x99\xda\xc1\x38\xe8\x96\x29\xd8\x30\x4e\xe4\x63\x01\x4a\x00\xb1\x02\x9c\x38\xfa\xa0\x25\xa9\xcc\xde\xae\x08\x87\x0d\x85\xaa\xa5\xd5\x1d\x02\x19\x6d\xf9\x91\x54\x69\x21\x69\x5d\xce\xf6\x89\x99\x75\xcd\xb5\x64\x54\x7d\xc7\x1b\x71\xde\x5e\x59\xae\x3a\x65\x34\xd0\x4e\x51\x58\x68\x7c\xc5\xba\xa3\xfa\xb0\x8c\x51\x59\xda\x32\x95\x5a\xd8\x58\xd6\x90\x5a\xa8\xb4\x80\x54\x52\xa2\x44\xa8\x6e\xde\x9a\xb5\x37\xd1\x08\x3f\xcf\x90\x78\xc5\xe9\xc8\x69\x31\x56\xed\xb4\x9f\xec\x26\x7f\xd2\x93\xc3\x99\x4b\xb5\xe2\xfe\x0d\xe1\x37\x64\x4b\x67\x09\xa1\x27\xe7\x6d\xaa\x68\xfd\xe7\xa5\x2a\xa4\x6a\x1e\xcb\x87\x02\xff\x71\x88\xe4\xc8\x6f\xf1\xe5\x99\x6e\x71\xb3\x3f\x47\x39\x53\xaf\x69\x37\x64\x55\x5f\xe7\x1e\x41\x47\x79\x86\x35\x3a\xc7\xe2\x7b\x69\x95\x8d\x0a\x9d\x92\x47\x7a\x2f\x9d\xc0\x45\x69\x32\xd2\x22\xe7\xa1\xa0\x5f\x2e\xdf\x9f\x5f\x92\x50\xc0\x5f\x29\x47\x74\x11\x4c\x3f\xfc\x6d\x5f\xef\x95\x70\x63\xdd\x59\x5f\x4c\x10\x72\x2e\xa6\x64\x15\x22\xb8\x06\xfa\x40\x2b\x44\x10\xd4\xda\x3c\xfb\xc0\x74\x01\xe2\x0e\x81\x52\x29\xcb\xec\x49\xd5\xb2\xae\x46\xa5\xf9\x37\x38\x1e\xe7\xd8\x07\xa6\xcb\x41\x8a\x81\x6c\x89\x36\x31\x38\x93\xfe\x38\xdd\xc7\xc9\xcf\x04\xdb\xf3\xe9\x89\xd8\x3b\x32\x8e\x82\x5d\xf7\x30\x6d\x49\xf7\x5c\x9a\x79\x87\x80\x44\x08\x9c\xbc\x3f\x45\xe0\x64\x4e\x38\x02\xaf\xb5\xf6\xa0\xdc\xb8\xcd\x58\x83\x6f\x58\xca\xef\x05\xe3\x99\x6b\x4d\x0a\x48\x4b\x85\xd3\x36\xe2\x01\xeb\x5b\xdc\x82\x2c\x97\x44\xe2\x0a\x48\x3d\xd9\x4a\x31\x45\x38\x43\x85\x2a\xdf\xdc\xd5\x4c\x5e\x75\xdd\x7c\xb6\x50\x25\x66\x9e\x37\xa2\xa6\xd9\xe5\x3f\x2f\x2f\xf3\xfc\x74\x3c\x8c\xa5\x76\x3b\xc4\x32\x23\x74\x7f\x4b\xa6\xc9\xcc\xb7\xb4\x1a\x25\x7d\x27\x29\x9d\x63\x8d\x94\x9c\x21\x31\x59\xf9\xf6\x6c\xe9\xae\x19\x58\xe7\xad\x97\x4c\xbe\x25\x3d\xc5\x86\x6c\xe5\x7a\xb8\xe5\x32\x27\xae\x80\x74\xc3\x0c\xe5\x98\x57\x09\x1f\x67\x2c\x0f\xb8\x07\xd2\x02\x42\x28\x37\x44\xe1\x40\x10\xe1\xd4\xa6\x93\x55\x05\xa4\xae\x08\xa7\x05\xdc\xd8\x5f\xaf\x89\x6a\xf3\x69\x63\x71\xd6\xe1\xa6\xc2\x2e\xd3\xad\x46\x88\x1d\xd9\x96\x2f\x84\xe8\xb2\x48\xa4\xa1\x22\x05\x98\x1c\xee\xe7\xa6\xb9\x5f\x89\x4c\x6d\xb9\x90\x35\x8f\xd1\x3a\x37\x09\x1b\xc5\x8d\x25\x63\x6a\xa2\x42\xd8\x35\x12\xa5\xa8\x86\x96\xa8\x96\x2a\x27\x37\x10\xf6\xb9\xec\xdf\xd4\x0c\x91\xc9\x8a\xf0\xcc\xe3\x47\x61\xcf\x6c\xcb\x42\x14\x7c\x7f\xfb\xe3\x5b\x27\xce\x8e\xcd\x24\x99\xa1\x3d\x51\x88\xd1\x4e\xee\xc4\xb6\x30\xfc\xa5\xeb\x7c\x7b\xab\x40\x48\x1c\xc2\x49\x5b\xb6\xa3\x1c\x04\xa7\x4e\x43\xc4\x74\x9d\x9a\x5b\x93\x26\x2c\x07\x7e\x36\x9a\x57\x18\x4b\xfc\xd7\x3e\xc5\xfc\xc7\x76\xce\x04\x24\xed\x28\x51\x14\x6a\x26\x69\xa5\x85\x7c\x44\x95\x3f\xff\xf4\x43\x70\xb7\xed\x49\x0e\x45\x4a\x45\xd6\x74\x4c\x59\x63\x1c\x8b\xa2\x35\x44\xdf\x31\x8a\x1a\xd5\x1e\xd2\x51\x4d\x08\xe5\xc8\xf7\x1b\xfb\x88\xca\xed\x89\x85\x8c\x74\x4a\x40\xfa\x74\x60\xfc\x9a\xef\x9e\xa6\xb9\xd3\x13\x75\x44\x0b\x75\xe1\x8d\xd7\x6a\x63\x40\xe2\x35\xaa\x92\x6c\xd0\xd0\x08\x09\x1b\xa2\xda\x02\x7e\x57\x2d\xfa\xa4\x61\xaa\x75\x1a\x7a\xb2\x27\xba\x27\x93\x4c\xb3\x69\xc3\xd6\xab\xcd\xf4\x01\x69\x88\x68\xac\x2a\x34\xd0\x47\xd1\x50\x84\x99\x73\xcd\x50\xfc\x11\x68\x22\x35\xfc\xe3\xf6\xf5\xf5\x0f\x3f\x14\x88\x24\x7d\x6a\xa6\x18\xa3\x0b\xb8\x67\xba\x9d\xa9\x9b\xf6\x0e\xe3\x70\x73\xf5\xee\xf5\xdc\x2d\x74\xae\xcc\x8f\xee\xed\xcb\xe0\x11\x65\x7c\x31\x01\x2f\x30\x90\xa6\x7c\xec\x39\xcd\x64\x6c\x53\x33\xa2\x83\x04\xc0\x72\xeb\x4a\x98\xad\xba\xf0\xcb\x7b\xff\x48\xa5\xb4\x45\xc5\xf0\x9d\xa9\x2e\xc7\xa9\x0b\x53\x0e\x72\x86\x2b\xc7\x5d\x0a\xf0\x0f\x5f\x3f\x7f\x9f\xac\x7c\x6b\x5e\xf8\x26\x6e\xd6\x7c\x17\xee\x40\x61\xd6\x48\xc2\xda\xdb\x7a\xf0\x4f\x9a\x06\xa9\xaa\x80\x27\x6e\xdf\x46\x2f\x83\xe1\x45\xc4\x78\xd6\x26\xfc\xae\x8b\x42\x9a\x5a\x78\x32\x39\x7b\xc3\x1a\xa4\x3d\xc8\xe6\xd2\xa9\x3c\x9a\xec\x6f\xa2\xf2\x33\x52\x3b\xd3\x4b\x91\x2d\x4d\xfc\xe8\x0d\xba\xd3\x30\xe6\xd9\xa9\x0c\xae\x72\x75\x27\x3e\x40\x38\x76\x10\x11\x58\x55\x60\x8b\x71\xe7\xbb\xff\x32\x1c\xc6\xcc\x63\x83\x98\xae\xe4\x36\xbb\xcc\x0b\x93\xd5\x0f\xad\xdd\x6b\xf9\x3f\x7e\x8c\xce\x51\x3e\x7e\x9c\x3c\x17\x8e\x2e\x70\xb0\x27\xf1\x53\x20\xe3\xfb\x0a\x66\x54\x13\x47\x0c\xac\xb7\x88\x2b\x87\x7f\x79\xae\x7c\x1c\xb8\x7f\x50\x59\x6e\xb7\x92\x15\x1d\x2b\x4a\xf6\xe4\x06\x12\x1e\xc2\x95\xe5\x21\x0e\xd8\xd9\x5f\xe3\x86\x6e\xb2\xb4\x67\x4a\x21\x6f\x43\xe5\xa6\xc3\x77\x7d\xdc\x51\x7f\x22\x92\xcb\xf7\x87\x90\xd9\xfe\x69\x5e\xaf\xa7\x6f\x0c\x93\x0e\x91\xdb\xdd\x65\x1a\xbe\x0d\x14\x93\x56\x3d\xd6\x62\xa3\x29\x25\x76\xa2\x63\xbd\xce\x46\x4f\xf1\x96\xfd\x64\x20\x7a\xf8\xe9\x7f\x28\x80\x93\x9e\x9a\x16\x88\xf0\x2d\x85\x2b\x2c\x9f\xc8\x36\x94\x3d\x15\x5e\x99\x7a\xca\x78\x23\x26\x62\x6a\xa6\x18\x61\xb8\x34\xea\x30\x0f\x53\x7c\xef\x6d\xfc\xe2\x27\x19\x46\xea\x0d\xd1\x6d\x60\x2e\xe7\x0b\x5b\xad\x30\x83\x7c\x17\x63\x32\x9f\x05\xd1\x19\x2a\x39\x22\x64\xee\xe1\xb9\x5b\xc0\xc5\xd3\xd4\x0d\x3c\x9e\x2f\xc0\xc2\x4d\xa9\x94\xa1\x05\x39\x60\x15\x6b\x60\xe8\x08\xe3\xb7\xec\x77\x6a\xfc\x51\xc0\xe4\xb1\x1c\xf5\x7b\xa8\xa5\x99\x91\xcf\x0c\xea\x99\xea\x89\xae\xda\xcc\x29\x53\xec\x77\xcc\xd6\xc7\x05\x16\x4b\x71\x79\xf8\x68\x5c\xaf\x6e\x98\xed\xb4\xa2\x34\x8f\xf9\x1c\x89\x1d\x3b\x89\xa4\x17\x35\x22\x39\x29\xb3\x58\x4a\x8c\xc0\xcc\x26\xbf\x63\x48\x5e\x97\x10\xdc\xe8\x49\x14\xe6\x5e\xa4\x38\x24\xad\x38\x20\xcb\xeb\x47\x36\x41\xf7\x42\x2c\xee\x16\xd1\x9d\x75\x46\xfb\x07\x3d\xd1\xe5\x47\x7c\x6c\x12\xbe\x9f\x8b\x0b\xb8\xb6\xcc\x05\x38\x7d\xd0\xa0\x45\xb8\x74\x11\xa0\x5b\x62\x49\x93\xa4\xe6\x0b\x63\x0a\x88\x16\x3d\xab\x0c\xb1\x74\x32\x6b\x23\x05\x2d\x51\xfe\x5c\xaa\x25\xb2\xee\x18\xc7\xa3\x29\xc6\xb5\x30\x22\xc4\xe6\x37\x5a\x69\x30\x4b\xca\x64\xa5\x69\x3f\xbc\x8c\x3a\x45\x7b\xd3\x54\xbe\xb3\xc3\xf3\x16\x02\x93\xd6\xad\xbb\x34\x49\xcb\x2f\xd5\x87\xf4\x40\x5b\x71\x4e\x0f\x64\x6f\x48\xd0\x21\x2e\x68\x42\x95\x3f\xd1\x5e\xec\x28\xf6\x58\x0e\x12\xba\xd4\xd4\x32\x4b\x6c\x9e\xaf\x01\x23\x58\xbe\x15\xf7\x98\x48\x67\xa7\x50\x26\x8b\xa8\x68\xe1\x9f\x22\x38\x74\xf0\x65\xa3\x82\x81\xc5\x8b\xb7\x02\x26\xd7\xa4\x66\xcf\xe0\x1b\x3c\x65\x89\xb3\x5a\x8e\x93\x47\x49\x1c\x57\x34\x00\x6f\x19\xaf\x68\x66\x40\xe7\xbe\x33\x35\x49\xe5\x27\x13\xbc\x6c\x12\xeb\x02\x6c\xe1\x0a\x55\x7e\xa7\xde\x0a\x7d\xfd\xc0\x94\xce\x30\x47\x1c\x6c\xf5\xa2\xee\x31\xca\xc3\x7f\xcb\x60\xa8\xb1\x59\x38\xd4\xbb\x9d\xb6\xcc\x6b\x30\xe7\xfd\x6e\x9f\x84\xdd\x8e\x54\x12\xcf\x32\x5e\xe1\xae\x5f\xdb\x58\x13\xb9\xbd\x8c\x19\xde\xae\x00\xca\x77\xbb\x89\xe7\xe5\x90\x29\xc7\x47\x94\xb9\x90\x83\x5f\xde\x6f\x1e\x35\x5d\xf2\x3f\xf3\xb1\xd0\x0a\x2c\x00\x05\x82\x77\x8f\x70\xdf\x52\x0e\xee\xba\x46\x95\xde\x53\xee\x5e\xb4\xc4\xf9\x46\x7f\xac\x78\xbf\xc0\x45\x27\x3f\x87\xa9\xe8\xb9\x10\xab\xfb\xba\x80\x0f\xae\x9a\xfc\x9b\xea\xfb\x1a\xa3\x54\xf5\x35\xd6\x29\x1c\x5e\xb4\xdc\xa6\x13\x37\x35\x38\x04\xd3\x9f\x18\x3f\xd0\xca\x54\x37\xb7\xfa\xd8\xb9\x7e\x7c\xc4\xe4\x6f\x03\x70\x2d\x06\xf9\xac\xb5\x33\xf7\xf8\xbb\x4a\x4d\xe5\x20\xa9\xa6\xf2\x4a\x6e\x77\x5e\xcc\xec\x54\xec\xa8\x3c\x77\x2b\x1b\xae\x0d\x5d\x67\x70\xeb\xc6\xb3\x99\xb5\x87\x65\x98\xfd\x11\x96\x5e\xf3\x5d\x16\xc4\xf6\x04\x3b\xba\xac\x63\x1b\x49\xe4\x23\xfe\xc4\x7b\x67\xbe\x63\x12\xcf\x8d\xe3\x54\xb4\x06\x32\x0c\xdd\xe3\xb7\x56\x0a\x26\x36\x2f\xe5\x3c\x6f\xca\x91\xbf\x16\xe2\x2e\x6b\x85\xb8\xbb\x91\x14\xdd\x1a\x9d\x4a\xba\xdd\x74\x4a\xd2\xfc\xf3\xc3\x6d\x16\x15\x10\xeb\x78\xc3\xcd\x52\x1f\x00\x97\x83\xaa\x03\x88\x43\x49\xf5\x43\xa6\x66\xe6\x47\x32\xe5\x7c\xbf\x16\xc1\x22\xfc\x34\x83\xd4\x43\x5f\x07\x16\x5f\xa4\xf6\xd7\x9c\x6c\x3a\x5a\x67\xd3\x69\xb3\x1d\x8d\xae\x0e\xed\xad\x6b\xe4\x11\xf3\x01\x18\x3c\x96\xf1\x7c\x13\xd6\xc4\x44\xea\x4f\xef\xe1\x83\xf4\xf9\x81\xa4\xb9\x72\xf7\x22\x67\x97\x3e\x18\x23\x93\x45\x95\x0d\x97\x50\x1a\xe3\xe5\xf2\xe9\xc8\x71\xc0\x04\x73\x11\xbf\xbd\x13\xce\x90\x15\xa6\xc3\xd1\x46\xc8\x9e\xe8\x90\x1a\xa0\x2c\x4b\xf3\x8d\x34\xa4\xa2\x7f\x7c\x9a\xfe\x8f\x86\x63\x66\x19\xca\xff\x1c\xd2\xa7\x56\xd0\xd3\xd4\x9a\x51\x96\x65\xc8\xa7\x5f\x27\x9f\x92\xff\x0f\x00\x37\x42\x22\x7d\x3e\x22\x00\x00")
 
 func sushibox_go_bytes() ([]byte, error) {
 	return bindata_read(
@@ -76,12 +436,32 @@ func sushibox_go() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "sushibox.go", size: 3332, mode: os.FileMode(420), modTime: time.Unix(1418097391, 0)}
+	info := bindata_file_info{name: "sushibox.go", size: 8766, mode: os.FileMode(420), modTime: time.Unix(1792412867, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _verify_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa4\x58\x6f\x6f\xdb\xbe\x11\x7e\x6d\x7f\x8a\x8b\x80\x14\xd2\xe0\x9f\xb2\x15\x4b\x81\x25\xc8\x8b\xe5\x57\x77\xe9\x82\xb5\x41\xdd\x76\x2d\x8a\x22\xa0\xa5\x93\x45\x58\x22\x05\x92\x72\xac\x05\xf9\xee\xc3\x91\xfa\x43\xd9\x6e\x62\xa0\x6f\xec\x88\x22\x9f\xbb\x7b\x8e\xf7\xdc\x39\x15\x4b\xd6\x6c\x85\x50\x32\x2e\xa6\x53\x5e\x56\x52\x19\x08\xa7\x93\x20\x51\x4d\x65\xe4\x19\xa6\xaf\xcf\xcf\xff\xf6\x8f\x60\x58\xd1\x39\x7b\x7d\xfe\xc6\x5b\xd8\x9e\xff\xd5\xbe\x47\x91\xc8\x94\x8b\xd5\xd9\x92\x69\x7c\xf3\xf7\xd1\x52\x8e\xdb\xd1\x73\x85\x25\x3d\x67\xa5\xa1\x2f\x2e\xdd\xe7\x19\x97\xb5\xe1\x05\x3d\x48\x4d\x9f\x15\x33\xf9\x59\xc6\x0b\xa4\x3f\x82\x69\x34\x9d\x9e\x9d\x41\xc9\x04\xcf\x50\x9b\x05\x5f\x09\x66\x6a\x85\xc0\x44\x0a\x9a\xaf\x04\x17\xab\x5b\x6c\x80\x29\x04\x85\x55\xc1\x12\x4c\x61\xd9\x80\xae\x75\xce\x4b\xa6\x0d\x2a\x78\xc8\x51\x80\xc9\x91\x80\x96\xb5\x48\x0b\x04\xae\x61\x59\xf3\xc2\xc0\x03\x37\x39\xfc\x41\x40\x7f\xac\xb1\x89\xe1\x5a\x9a\xdc\x82\xb9\x80\xc0\x7a\x8f\x69\x3c\xdd\x30\x75\xc0\x8b\x2b\x08\x02\xfb\xca\x73\xc5\xae\x4d\x13\x29\xb4\x81\x0d\x2a\x9e\x35\xb7\xd8\xcc\xc5\x86\x5e\x2c\xbe\x2c\x6e\xde\x5f\x7f\xfc\x76\xff\x75\xfe\xe9\xfd\xbb\xef\xf7\xb7\xf3\xef\x81\x0d\xb0\xdf\x08\x0a\x4d\xad\x84\x26\x87\xa1\xaa\x97\x05\x4f\x60\x8d\x0d\x24\x52\x64\x7c\x55\x2b\x17\xde\x01\x9c\x19\x48\x45\x48\x74\x4e\x0a\x04\x2c\x97\x98\xa6\x98\x02\x33\x36\xd6\x14\x0c\x2f\x31\x9e\x66\xb5\x48\x06\x73\x61\x04\x61\x9b\xef\xf8\xce\x5a\xbb\xc5\x66\x06\xa8\x94\x54\x11\x3c\x4e\x27\x3c\x03\x4a\x04\x5c\x5c\x81\xd4\xf1\xbf\xd0\xa0\xd8\x84\x7e\x58\xd1\xa5\xdb\x70\x42\x71\xd3\x89\x89\x8b\x00\x14\xb2\xb4\x87\x0c\x69\x4f\x34\x9d\x3c\x59\x44\x9f\xad\xdd\x63\x82\x17\x33\xfa\xb0\x7b\xd7\xad\x33\x64\xde\x65\x24\x5e\x98\x74\xde\x5e\xa9\xf8\x2d\x52\x76\x16\x46\x71\xb1\x0a\x07\xd0\xc8\x1a\xa1\x63\x27\x57\x04\xb5\x07\x8f\x4a\x75\xae\x14\x28\xc2\x35\x36\x11\x6d\xdd\x23\x62\xc1\xff\x87\x7b\x87\xb3\xd2\xc4\x73\xe2\x27\x0b\x83\x9e\x64\x2f\x53\x39\xd3\xf0\xa0\xa4\x58\x81\xa6\xe3\xa7\x69\x30\xeb\xad\x38\x06\x5a\xb4\x3d\x73\x76\x8b\x0b\xfe\x69\xea\x12\xb5\x4f\x22\x68\x1b\xee\x4b\x79\x5b\xd6\x59\xcf\x9c\x2b\xb1\xf8\x13\xb2\xf4\x1d\x2f\xb0\xcb\xc5\x71\x1c\x2d\x0b\x99\xac\x67\x70\x4f\x40\x15\x96\x2d\xe7\xe1\xb2\xce\x1c\x84\x7d\x0f\x57\x87\x41\x7c\xae\x84\x84\xbb\xf9\x7f\x20\x65\x86\x01\x17\x70\xaa\x83\x19\x0c\xb7\xc2\xcf\x34\xa9\x4b\x7c\xc7\x94\xc6\xbb\xdb\xf7\xdf\x86\xf0\xad\xa9\xf8\xba\x31\xa8\x5f\x74\xdf\xb7\x5c\x11\x94\x9f\xa2\x53\x7d\x01\xa7\x9b\xd6\xbe\x35\xeb\x9c\xa8\xea\xe5\x0c\xe4\x9a\x7c\x20\x35\xd8\x67\xd8\x99\x3d\x91\xeb\xe7\xed\xf9\x96\x80\x6b\x10\xd2\x74\xd9\x1e\x05\xdd\x02\x58\xb3\x6d\xd6\x7b\x2d\x18\x44\x26\xc9\x31\x59\x3b\x41\xe8\x14\x08\x74\xf7\x36\x86\x2f\x82\x1e\x30\x6d\xb5\x4d\x43\xc5\xb4\x26\x9c\x5a\x14\xa8\x35\xb0\x16\xd0\xfa\xc3\xb5\xa7\x24\x23\x31\xe8\xed\x85\x91\xbb\x46\xf0\x38\xce\x8a\xa7\x19\xcf\x90\xef\x95\xd6\xda\x95\xf7\x7e\x76\xba\x0d\x07\xf4\x74\x57\x0d\x7c\x5a\x07\xed\x26\x3e\x5d\xd0\x81\x23\x52\xf3\xd5\xd1\x3a\xb1\x67\xf5\xc8\x70\x4e\xba\xdb\xf0\xd5\x12\x11\x5a\x6e\x7e\xfc\x5c\x36\x06\x7b\xcc\x7f\x2f\x3e\x7e\x88\x66\xe4\x5b\xf4\xab\x28\xf6\x53\x08\xa9\x44\x17\x53\xc9\x4c\x92\x07\xa3\xbb\x31\x5c\x8b\xee\x20\x95\xf0\xa8\x4b\xa0\x30\xaa\x01\x99\x01\x03\xea\x9b\x33\xc8\x64\x51\xc8\x07\x2e\x56\x90\x33\x95\x16\x5c\xd0\xed\x91\x5d\x0b\xa4\x3d\xf4\x77\x03\x0f\x68\xbb\x66\x22\x95\xed\x13\x2b\xc6\x85\x36\xed\xa5\xf0\xad\x85\x82\x95\xd8\xeb\xce\x5f\xba\x57\x73\x6b\xf7\x71\x3a\xc9\xa4\x82\xfb\x19\x20\xb1\xaf\x98\x58\x79\xf7\x94\x48\x20\x72\xe3\x0f\x04\x41\xb7\x81\xbe\x5f\xbd\x02\x8c\x3f\x37\x95\x5d\xb1\xee\xdf\xb4\x8e\xda\x03\x13\xbb\xe9\x8a\xf6\x30\xb5\x42\x43\x4b\x4b\x85\x6c\x3d\x9d\x10\x33\x4f\xce\x22\xff\x3d\x8b\x96\x46\x6b\xad\x65\xfa\x55\x07\xf1\x83\xff\xec\x0d\xfd\x32\x0b\x37\x4c\xe7\xa3\x2c\xf4\x3c\xe6\xf4\xa6\xcf\xc6\x0e\x9d\x74\x6c\x4c\xa7\xfb\x6e\x5b\xad\x0d\x68\x8f\xfa\xe8\x12\xf0\xd0\xdd\x8c\x09\xcd\x77\x33\x08\xfa\xc6\xe1\x46\xb6\x1b\xdc\x86\x0a\xb8\xb4\xda\x8f\x2a\x82\xd0\x99\xf3\x3b\x85\xed\xed\x6e\x7b\xfc\x01\x1f\xda\xea\xbe\xf7\xba\x47\xfc\xa7\xac\x9a\x30\x9f\x81\x8a\x2e\x7f\x51\x27\x41\x30\x74\x8c\x76\x29\xc7\x6d\x6c\x2b\x10\x3f\xcb\xb6\xf4\xf2\x78\x51\x97\xa1\xe0\x45\x14\xed\x0b\xde\x3f\xb5\x46\xf3\x96\xba\x43\x2b\x78\x8c\x16\x48\xae\x0c\x0a\xa3\xbb\xfb\x39\xd2\xc1\x18\xfe\xcb\x4d\x2e\x6b\x03\x8c\x80\x86\x92\xc2\x0d\xaa\xa6\x45\xe0\x1a\x58\x92\x60\x65\x30\x9d\xb9\x81\x4f\x8a\xf1\x8e\xb2\xd6\x06\x96\x08\x05\xd7\x66\x47\x16\x7b\xaf\xfc\xac\xcd\x5c\x17\x73\xc5\xef\xc9\x25\x6e\x2b\x4c\x0c\xa6\x7e\x16\xfb\x8c\xb7\x32\xd3\x6d\x19\xa4\xee\xa0\x12\x0e\xe3\xd4\x21\x11\x71\x6e\x0f\xcd\x85\xdb\x01\xb7\xd5\xc4\x1e\x2d\x98\x41\x6b\x98\xd2\xb2\x2b\xc0\x2c\x31\x35\x2b\xbc\xe4\x2f\xea\xf2\xf5\xf9\x9b\x90\x42\x73\xbe\x1e\xc8\xa0\x3b\xf4\xe3\xe2\xa7\x1b\x98\xba\x60\x1e\xa7\xcf\xba\x79\xe1\x8a\x62\xac\x73\x07\xdc\x3c\x54\x70\x2e\x0d\xb6\x5a\xbb\x7b\x21\x00\xb7\x46\x31\x6b\xd9\xaa\xd9\xa1\xab\xd1\xd7\x21\x61\x8f\x52\xda\xd7\x95\x6b\xc5\x7d\x25\xfe\x66\x1a\x77\xe8\x1d\xc6\x2f\xa9\xe3\x8f\x15\x8a\x17\xc7\xae\xae\x7e\x52\xcc\x50\x41\x16\xff\x59\x48\x8d\x54\x8e\x8e\xf4\x1e\x6f\x28\xed\xec\xc8\xd6\xd5\xa6\xfa\x88\x8c\x59\x3a\x8f\x48\xd8\xde\x0c\x33\x24\xcc\xb5\x69\xab\xbe\xbe\x3e\x76\x87\x9d\x1e\xcb\xcc\x2e\x5a\x73\x15\x28\xd4\xb2\xd8\x20\x75\xa9\x36\x53\x03\x48\x58\x0d\x13\xaf\x4b\x5a\xbb\x3b\x05\x4f\xcb\x3c\x3d\xeb\x5e\xbb\xf5\x2b\xe8\x7e\x4b\xc6\xf3\x0d\x2b\x16\x4d\x69\xfb\x61\x58\x3d\x43\x9d\x9b\x7b\x99\xc6\x9e\xf2\xc3\x18\xd7\x4c\xe3\x5b\xae\x5e\x42\x52\x58\xec\x03\x7d\xc2\x22\x74\x26\x3a\x7f\x5f\x86\x71\xd9\xea\x10\x3e\xcb\x45\x41\x97\x52\x61\x11\x0d\x28\xfb\xba\x3a\xdf\x62\xe2\x97\x4f\xcf\x3b\x83\x44\x96\x25\xfd\x90\xf6\xf8\x87\x25\x66\x52\x21\xe0\x16\x93\x51\xd5\x74\x30\x61\x52\xa6\x77\x07\xcb\x66\x9c\x9d\x61\x1e\x1b\x52\xd9\x1e\x3d\xe6\xd6\xb6\x8f\x7b\x15\x3b\xb0\xe5\xc7\x78\x6d\x6d\xf8\x11\xea\xd1\x7f\x0a\x9c\xd8\x0f\x3f\x89\x49\x97\x46\xd1\x39\x00\x7f\xf4\xe5\xd9\x78\xf0\xf5\xe6\xe3\xcb\x17\x7c\x6f\xe7\x21\x7b\xd1\xfb\x01\xc5\x36\x12\x62\x41\x87\x6e\x36\x24\x9d\xed\x49\xb2\x6f\x3b\x7d\x39\xc0\xce\xc8\xc4\xe4\x69\x74\xfc\x0a\x52\xb4\xff\x97\x19\x40\x5c\x83\x3a\x16\x6a\x37\xd4\x71\xcf\x6b\xb1\x2e\x5f\xc4\xd9\x15\x83\xff\x0f\x00\x56\xf7\xe8\xf5\x68\x12\x00\x00")
+
+func verify_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_verify_go,
+		"verify.go",
+	)
+}
+
+func verify_go() (*asset, error) {
+	bytes, err := verify_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "verify.go", size: 4712, mode: os.FileMode(420), modTime: time.Unix(1792408636, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _version_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\xc8\x4d\xcc\xcc\xe3\xe2\x4a\xce\xcf\x2b\x2e\x51\x08\x4b\x2d\x2a\xce\xcc\xcf\x53\xb0\x55\x50\x4a\x49\x2d\x4b\xcd\xc9\x2f\xc8\xcc\x4b\x57\xe2\x02\x04\x00\x00\xff\xff\x21\xcf\xe4\x49\x2b\x00\x00\x00")
+var _version_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x54\x8e\xbd\x0e\xc2\x30\x10\x83\xf7\x3c\x85\x95\x07\x68\x9f\xa0\x3b\x6c\x4c\xec\x47\x72\x6d\x4e\x34\x3f\xca\x1d\x85\xbe\x3d\x6a\x99\xd8\x6c\xd9\xfa\xec\x46\xe1\x49\x0b\x23\x93\x14\xe7\x42\x2d\x6a\xb8\x73\x57\xa9\x05\x13\x7c\xe4\x8d\xd7\xda\xa4\x2c\xde\xb9\x71\xc4\x8d\xf6\xb5\x52\xbc\x90\x26\x88\xa2\x73\x5b\x29\x70\xc4\x63\x87\xbe\x34\x49\x26\x35\xee\x78\x8b\x25\x58\x62\xa4\xa3\x58\xe7\x53\x67\x2a\x32\xb3\xda\x80\xab\x1d\xac\x42\x99\xf5\x4c\xa2\x74\x0e\x56\xfb\x7e\xba\xf6\xdb\x38\xf8\xfc\xb1\x4e\xc1\x38\xc2\xea\xe0\x36\xea\x7f\x07\x26\x78\xef\xbe\x03\x00\x1b\x83\x90\xac\xc1\x00\x00\x00")
 
 func version_go_bytes() ([]byte, error) {
 	return bindata_read(
@@ -96,7 +476,27 @@ func version_go() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "version.go", size: 43, mode: os.FileMode(420), modTime: time.Unix(1418090591, 0)}
+	info := bindata_file_info{name: "version.go", size: 193, mode: os.FileMode(420), modTime: time.Unix(1792408176, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _versions_go = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x55\x41\x6f\xdb\x46\x13\x3d\x73\x7f\xc5\x98\x40\x02\x12\x61\xa8\x9c\xbe\x0f\x70\xa1\x43\x03\xb9\x8d\x93\x3a\x31\xa2\x24\xed\x2d\x59\x72\x87\xe2\x58\xe4\x2e\xb1\x3b\x4c\x2c\xc4\xfe\xef\xc5\x2e\x49\x89\x52\x55\xd4\x3e\x58\xd2\x72\x67\xe6\xbd\x37\x6f\x86\x9d\x2c\xb7\x72\x83\xd0\x4a\xd2\x42\x50\xdb\x19\xcb\x90\x88\x28\x46\x5d\x1a\x45\x7a\xb3\xb8\x73\x46\xc7\x22\x8a\xab\x96\xfd\x07\x99\x05\x99\x9e\xa9\xf1\x3f\x8c\xf3\xff\x3b\xc9\xf5\xa2\xa2\x06\xfd\x97\x58\xa4\x42\x94\x46\x3b\x06\x85\xaa\xef\xf0\x4a\x7f\x87\x25\xc4\xeb\xcf\xeb\x37\xd7\xaf\x3f\xfc\xf5\x75\x75\xb5\xfa\x7c\x7b\x15\x0b\xc1\xbb\x0e\xe1\x3b\x5a\x47\x46\x5f\xeb\xca\x80\x63\xdb\x97\x0c\x3f\x45\xf4\x65\x38\xf5\x27\xa4\x37\xf0\xcd\x63\xb8\x8c\xc7\xbb\xf1\x37\x11\xdd\xca\x5d\x63\xa4\x3a\xb9\xd0\x0d\xa7\x99\x69\x89\xb1\xed\x78\x17\x7f\x13\x22\x5a\x2c\xe0\x46\x6a\xaa\xd0\x31\x34\xc8\x0e\x24\x74\xa4\x35\x2a\xc0\x7b\xb6\xb2\x64\x5f\xa9\x08\x50\xa8\x22\x54\x20\x2b\x46\x0b\x5c\x23\x14\xa4\xa5\xdd\x01\x71\xc8\x52\xca\x16\xa1\xb2\xa6\x85\x5a\x3a\x28\x10\x35\x58\xec\x1a\x59\xa2\xca\x61\x4d\x1b\x2d\xb9\xb7\x08\x52\x2b\x78\x87\x3b\x90\x16\x81\x6b\xe3\x10\x4c\xe5\xb3\x85\x1c\xed\x88\x24\x03\xe9\x42\x09\xaf\x1b\x10\x3b\x6c\x2a\x20\x07\xda\x30\x38\xda\x68\x54\xb9\x88\xf6\xb0\x4f\x78\xee\x93\xcc\x89\x46\x07\x04\xc7\xb7\xdd\x74\x7e\x7c\xdd\x43\x1c\xfe\x8e\xaf\x6f\x71\x77\x74\xf1\x51\x88\xc5\x62\xea\xd3\x8a\xec\x7b\xaf\x02\x0d\xe0\x47\xc1\xbd\x1e\x75\x06\xce\x00\xd7\x92\x81\x14\x6a\xa6\x52\x36\x50\x1a\xcd\xa8\x19\x5c\x2d\x2d\x3a\x90\xda\xa7\x3a\xa8\x9e\x81\x19\x74\x1e\xb3\x43\x65\x2c\x14\xbd\x56\x0d\x3a\x28\x7a\x6a\x18\x7e\x10\xd7\xa6\x67\x90\x7b\xe5\x72\x51\xf5\xba\x3c\x01\x94\xa4\x13\x8b\x9f\x22\xa2\x0a\x46\x7f\xbc\x91\xae\x86\x8b\x25\xc4\xb1\x3f\x8f\x2c\x72\x6f\xf5\xfc\xa1\x88\x1e\xc5\x74\x3c\x9a\x6e\x24\xfc\xc3\x12\xe3\x97\x99\x3b\xb7\x88\xdd\xc0\xba\xee\x5b\xe9\x5b\x2f\x95\x2c\x9a\x03\x78\x8d\xf7\x0c\x6c\x42\xab\x17\x8b\x89\xfb\x4b\xa9\x94\x45\xe7\x50\x81\x22\x8b\x25\x1b\xbb\x1b\x29\x9c\x96\x48\x52\x40\x6b\x8d\xf5\x58\x8b\xbe\xca\xfc\x2f\xb8\x5c\x82\xef\x4b\x7e\x23\xad\xab\x65\x93\xcc\x06\xe6\xe7\x18\x7b\x39\x41\xcf\x26\x6a\x97\x73\x8e\xd9\xde\xfe\x97\x7b\x11\xdf\xae\x3f\xbc\xcf\x0e\x9e\x3d\x3c\xd8\x1f\x65\xde\xc3\x97\xc1\x8b\xa4\x37\xef\x70\xf7\x98\x06\x65\x3d\xa6\x8b\x25\x68\x6a\xe6\x92\xa2\xb5\x73\x29\x87\x0d\x91\xff\xe9\x09\xfe\x46\x0d\x26\xaf\xa5\xc3\x15\xd9\x17\x71\x1e\xd6\x49\x06\x81\x9f\x71\xb9\x7f\x7a\x63\x14\x26\xaf\xfe\xf7\xea\x55\x9a\x7a\xf1\x83\x36\xd3\xee\xf0\x0a\xab\x24\x85\xc2\x98\x50\x70\x2c\x60\x5c\xfe\x3b\x32\xea\xef\xc9\x7e\xc9\xa4\x43\xa3\xc7\xf6\x0d\xc7\x3e\xbb\x9b\x86\xd4\x7f\x71\x6c\x2c\xaa\x30\x74\x0e\x7a\xad\xd0\xfa\xae\x04\x97\x41\x2d\xad\x6a\x48\x6f\x1d\x90\x3e\xb4\xd1\x14\x77\x58\x32\x84\xc0\xc1\xc7\x0a\x8a\x1d\xc8\xa6\x99\x1a\xef\x82\x8d\xa5\x52\xc1\x1d\x2d\xb0\x01\xe2\x1c\x3e\x84\x40\x07\xd2\x86\x3c\x5b\xdc\x0d\x91\xc3\xac\xb4\x46\x0d\x9b\xa2\x65\x6a\x11\x1c\xe9\x12\x67\x08\x42\xa1\x50\x84\x6b\x8b\x98\xcf\x45\x09\xa4\x12\x0f\x7b\xb0\xfc\xcc\x35\x03\x58\xb7\xa2\xe0\x9b\x69\x25\xe7\x6f\x0d\xe9\x64\xdd\xbb\x9a\x5e\x9b\xfb\x15\xd9\x0c\xe2\xf1\x66\x9c\x8a\x68\x74\x99\x71\xf9\xcd\x56\x91\xfd\xb5\x69\x92\x43\x9e\x93\x26\xfd\x3f\x34\xe9\xbf\x7d\xe0\x9f\x2e\xa1\xac\xb1\xdc\xae\xb1\xec\x2d\xde\x4a\xae\x67\x69\x9f\x90\x43\x44\x7e\x1b\x7c\xcd\x00\x3d\x3a\x2b\xf5\x06\xf7\x2e\x0d\xd7\x7d\x82\xfc\x93\x7f\x89\x5c\x2c\x01\x35\xdb\x9d\xc7\x09\x0f\x0f\x80\x79\x18\xfc\xe5\x7e\xf0\x23\x3f\x8c\xa4\x7b\x14\x91\x47\x17\x75\xfb\xd9\x92\xce\x21\x07\x70\xca\x93\xc5\xdc\x6f\x93\x54\x44\x67\xe0\x1d\xe1\x0b\x69\x48\x57\x66\x9f\xc9\xb8\xfc\x0f\xc7\x92\x93\xee\xa9\xe1\xa6\xb8\xfb\x67\x9b\xe6\xd2\x57\x2d\xe7\xeb\xce\x92\xe6\x2a\x89\x9f\xb9\x97\xcf\xcc\xcb\x67\x2a\xce\x46\x7e\x19\xf8\xfa\x79\x68\x4c\x9a\xdf\xa2\x6d\x93\xf4\x70\xf6\x89\xfc\x56\xcc\x3f\x6b\xba\x4f\xd2\x34\x15\x43\xbd\xeb\xb3\x88\x4d\x71\x37\x62\x36\x2e\xbf\x76\xef\x0d\x5f\xdd\x93\xe3\x04\xad\x4d\x07\xec\x54\xcd\x83\x48\x6f\x93\x2e\xf3\xa3\x91\xfe\x32\xa7\xf9\xfc\x39\x5c\x84\x0c\xa7\xe1\xc7\xdc\xa3\xc7\x33\x2d\x79\x92\xde\x15\x5c\x8c\x24\x26\xda\xd7\xee\x23\x6e\xfa\x46\xda\x24\xf5\x9d\x3f\xe3\xb8\x74\xca\xf9\xf0\x30\xbc\xe2\x83\x4d\x92\xa1\xd3\x19\xcc\x6f\x9c\xb3\x0a\xb7\x9d\xa7\xdd\xc1\x0b\x88\xf3\x61\x00\x63\x71\x4e\x10\x53\xdc\x65\xc0\x6d\x77\x2c\xc9\xbf\x12\x39\x44\x7f\x44\xed\xdf\x60\xdc\x76\x19\x3c\x25\xfa\xb0\x66\x35\x35\xe2\x51\xfc\x3d\x00\x9f\xac\x8a\xf1\xc3\x09\x00\x00")
+
+func versions_go_bytes() ([]byte, error) {
+	return bindata_read(
+		_versions_go,
+		"versions.go",
+	)
+}
+
+func versions_go() (*asset, error) {
+	bytes, err := versions_go_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "versions.go", size: 2499, mode: os.FileMode(420), modTime: time.Unix(1792411782, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -142,8 +542,28 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"audit.go": audit_go,
+	"buildinfo.go": buildinfo_go,
+	"commands.go": commands_go,
+	"completion.go": completion_go,
+	"crypt.go": crypt_go,
+	"debug.go": debug_go,
+	"help.go": help_go,
+	"hooks.go": hooks_go,
+	"libs.go": libs_go,
+	"man.go": man_go,
+	"manifest.go": manifest_go,
+	"pin.go": pin_go,
+	"restore.go": restore_go,
+	"secure.go": secure_go,
+	"selfupdate.go": selfupdate_go,
+	"shebang.go": shebang_go,
+	"shell.go": shell_go,
+	"supervise.go": supervise_go,
 	"sushibox.go": sushibox_go,
+	"verify.go": verify_go,
 	"version.go": version_go,
+	"versions.go": versions_go,
 }
 
 // AssetDir returns the file names below a certain
@@ -186,10 +606,50 @@ type _bintree_t struct {
 	Children map[string]*_bintree_t
 }
 var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
+	"audit.go": &_bintree_t{audit_go, map[string]*_bintree_t{
+	}},
+	"buildinfo.go": &_bintree_t{buildinfo_go, map[string]*_bintree_t{
+	}},
+	"commands.go": &_bintree_t{commands_go, map[string]*_bintree_t{
+	}},
+	"completion.go": &_bintree_t{completion_go, map[string]*_bintree_t{
+	}},
+	"crypt.go": &_bintree_t{crypt_go, map[string]*_bintree_t{
+	}},
+	"debug.go": &_bintree_t{debug_go, map[string]*_bintree_t{
+	}},
+	"help.go": &_bintree_t{help_go, map[string]*_bintree_t{
+	}},
+	"hooks.go": &_bintree_t{hooks_go, map[string]*_bintree_t{
+	}},
+	"libs.go": &_bintree_t{libs_go, map[string]*_bintree_t{
+	}},
+	"man.go": &_bintree_t{man_go, map[string]*_bintree_t{
+	}},
+	"manifest.go": &_bintree_t{manifest_go, map[string]*_bintree_t{
+	}},
+	"pin.go": &_bintree_t{pin_go, map[string]*_bintree_t{
+	}},
+	"restore.go": &_bintree_t{restore_go, map[string]*_bintree_t{
+	}},
+	"secure.go": &_bintree_t{secure_go, map[string]*_bintree_t{
+	}},
+	"selfupdate.go": &_bintree_t{selfupdate_go, map[string]*_bintree_t{
+	}},
+	"shebang.go": &_bintree_t{shebang_go, map[string]*_bintree_t{
+	}},
+	"shell.go": &_bintree_t{shell_go, map[string]*_bintree_t{
+	}},
+	"supervise.go": &_bintree_t{supervise_go, map[string]*_bintree_t{
+	}},
 	"sushibox.go": &_bintree_t{sushibox_go, map[string]*_bintree_t{
 	}},
+	"verify.go": &_bintree_t{verify_go, map[string]*_bintree_t{
+	}},
 	"version.go": &_bintree_t{version_go, map[string]*_bintree_t{
 	}},
+	"versions.go": &_bintree_t{versions_go, map[string]*_bintree_t{
+	}},
 }}
 
 // Restore an asset under the given directory
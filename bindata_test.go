@@ -8,7 +8,7 @@ import (
 )
 
 func TestAsset(t *testing.T) {
-	for _, name := range []string{"sushibox.go", "version.go"} {
+	for _, name := range AssetNames() {
 		expected, _ := ioutil.ReadFile(filepath.Join("sushibox", name))
 		actual, _ := Asset(name)
 		assert.Equal(t, string(expected), string(actual))
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+)
+
+var verbose = flag.Bool("v", false, "list embedded files and stream the go toolchain output")
+var dryRun = flag.Bool("n", false, "print what would be embedded and built without building")
+
+// buildTarget is the platform go build will target.
+func buildTarget() string {
+	goos, goarch := os.Getenv("GOOS"), os.Getenv("GOARCH")
+	if goos == "" {
+		goos = runtime.GOOS
+	}
+	if goarch == "" {
+		goarch = runtime.GOARCH
+	}
+	return goos + "/" + goarch
+}
+
+// writePlan describes the build: version, target, output and every entry
+// of the manifest.
+func writePlan(w io.Writer, entries []manifestEntry, version, output string) {
+	fmt.Fprintf(w, "version  %s\n", version)
+	fmt.Fprintf(w, "target   %s\n", buildTarget())
+	fmt.Fprintf(w, "output   %s\n", output)
+	for _, e := range entries {
+		switch e.Type {
+		case entryFile:
+			fmt.Fprintf(w, "%-8s %s (%d bytes)\n", e.Type, e.Name, e.Size)
+		case entrySymlink, entryHardlink:
+			fmt.Fprintf(w, "%-8s %s -> %s\n", e.Type, e.Name, e.Target)
+		default:
+			fmt.Fprintf(w, "%-8s %s\n", e.Type, e.Name)
+		}
+	}
+}
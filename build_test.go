@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWritePlan(t *testing.T) {
+	defer os.Setenv("GOOS", os.Getenv("GOOS"))
+	defer os.Setenv("GOARCH", os.Getenv("GOARCH"))
+	os.Setenv("GOOS", "linux")
+	os.Setenv("GOARCH", "arm64")
+
+	var buf bytes.Buffer
+	writePlan(&buf, []manifestEntry{
+		{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0755},
+		{Name: "bin/foo", Type: entryFile, Mode: 0755, Size: 19},
+		{Name: "bin/baz", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "foo"},
+	}, "20261019-120000", "/tmp/sushibox")
+	assert.Equal(t, "version  20261019-120000\n"+
+		"target   linux/arm64\n"+
+		"output   /tmp/sushibox\n"+
+		"dir      bin\n"+
+		"file     bin/foo (19 bytes)\n"+
+		"symlink  bin/baz -> foo\n", buf.String())
+}
+
+func TestBuildSushiboxError(t *testing.T) {
+	workDir, err := ioutil.TempDir("", "sushimaster_build_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(workDir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(workDir, "go.mod"), []byte("module broken\n"), os.FileMode(0644)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(workDir, "main.go"), []byte("package main\n\nfunc main() { undefinedFunc() }\n"), os.FileMode(0644)))
+
+	err = buildSushibox(workDir, filepath.Join(workDir, "sushibox"))
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "undefinedFunc")
+	}
+}
+
+func TestBuildSushiboxErrorVerbose(t *testing.T) {
+	defer func(v bool) { *verbose = v }(*verbose)
+	*verbose = true
+	workDir, err := ioutil.TempDir("", "sushimaster_build_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(workDir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(workDir, "go.mod"), []byte("module broken\n"), os.FileMode(0644)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(workDir, "main.go"), []byte("package main\n\nfunc main() { undefinedFunc() }\n"), os.FileMode(0644)))
+
+	err = buildSushibox(workDir, filepath.Join(workDir, "sushibox"))
+	if assert.NotNil(t, err) {
+		assert.NotContains(t, err.Error(), "undefinedFunc")
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+var bundleName = flag.String("name", "", "bundle name shown by sushibox -version (default the input directory name)")
+var semver = flag.String("semver", "", "semantic version shown by sushibox -version")
+
+// sushimasterVersion can be set with -ldflags "-X main.sushimasterVersion=...".
+var sushimasterVersion = ""
+
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// buildInfo is embedded in the sushibox and printed by -version.
+type buildInfo struct {
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Build       string `json:"build"`
+	BuildTime   string `json:"build_time"`
+	Builder     string `json:"builder,omitempty"`
+	Sushimaster string `json:"sushimaster"`
+	Payload     string `json:"payload"`
+	Assets      int    `json:"assets"`
+	Revision    string `json:"revision,omitempty"`
+}
+
+func currentSushimasterVersion() string {
+	if sushimasterVersion != "" {
+		return sushimasterVersion
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "devel"
+}
+
+// vcsRevision returns the git commit the input directory is at, marked
+// dirty when it has uncommitted changes, or "" outside of a git work tree.
+func vcsRevision(input string) string {
+	out, err := exec.Command("git", "-C", input, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	revision := strings.TrimSpace(string(out))
+	out, err = exec.Command("git", "-C", input, "status", "--porcelain", "--", ".").Output()
+	if err == nil && len(strings.TrimSpace(string(out))) > 0 {
+		revision += "-dirty"
+	}
+	return revision
+}
+
+// makeBuildInfo describes the build. Reproducible builds leave out the
+// builder and use SOURCE_DATE_EPOCH as build time.
+func makeBuildInfo(input string, entries []manifestEntry, version, manifest string) (string, error) {
+	if *semver != "" && !semverPattern.MatchString(*semver) {
+		return "", fmt.Errorf("invalid -semver %q", *semver)
+	}
+	info := buildInfo{
+		Name:        *bundleName,
+		Version:     *semver,
+		Build:       version,
+		Sushimaster: currentSushimasterVersion(),
+		Payload:     payloadHash(manifest),
+		Revision:    vcsRevision(input),
+	}
+	if info.Name == "" {
+		abs, err := filepath.Abs(input)
+		if err != nil {
+			return "", err
+		}
+		info.Name = filepath.Base(abs)
+	}
+	for _, e := range entries {
+		if e.Type == entryFile {
+			info.Assets++
+		}
+	}
+	if *reproducible {
+		epoch, err := sourceDateEpoch()
+		if err != nil {
+			return "", err
+		}
+		info.BuildTime = epoch.UTC().Format(time.RFC3339)
+	} else {
+		info.BuildTime = time.Now().UTC().Format(time.RFC3339)
+		info.Builder, _ = os.Hostname()
+	}
+
+	buf, err := json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
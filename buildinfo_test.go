@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMakeBuildInfo(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	manifest, err := marshalManifest(entries)
+	assert.Nil(t, err)
+
+	*semver = "1.2.0-rc.1"
+	defer func() { *semver = "" }()
+	s, err := makeBuildInfo(input, entries, "20261019-120000", manifest)
+	assert.Nil(t, err)
+	var info buildInfo
+	assert.Nil(t, json.Unmarshal([]byte(s), &info))
+	assert.Equal(t, filepath.Base(input), info.Name)
+	assert.Equal(t, "1.2.0-rc.1", info.Version)
+	assert.Equal(t, "20261019-120000", info.Build)
+	assert.Equal(t, payloadHash(manifest), info.Payload)
+	assert.Equal(t, 1, info.Assets)
+	assert.Equal(t, "", info.Revision)
+
+	*semver = "latest"
+	_, err = makeBuildInfo(input, entries, "20261019-120000", manifest)
+	assert.NotNil(t, err)
+}
+
+func TestMakeBuildInfoReproducible(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	*reproducible, *bundleName = true, "data"
+	defer func() { *reproducible, *bundleName = false, "" }()
+	defer os.Setenv(sourceDateEpochEnv, os.Getenv(sourceDateEpochEnv))
+	os.Setenv(sourceDateEpochEnv, "1700000000")
+
+	s, err := makeBuildInfo(input, nil, "0123456789abcdef", "[]")
+	assert.Nil(t, err)
+	var info buildInfo
+	assert.Nil(t, json.Unmarshal([]byte(s), &info))
+	assert.Equal(t, "data", info.Name)
+	assert.Equal(t, "2023-11-14T22:13:20Z", info.BuildTime)
+	assert.Equal(t, "", info.Builder)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+)
+
+var configPath = flag.String("config", "", "JSON file with per-command settings embedded in the sushibox")
+
+// bundleConfig is read from -config. Values may refer to the extracted
+// payload as ${SUSHIBOX_ROOT}.
+//
+//	{"commands": {"java": {"env": {"JAVA_HOME": "${SUSHIBOX_ROOT}/jdk"},
+//	                       "unset": ["CLASSPATH"], "dir": "data",
+//	                       "mode": "supervise", "group": "Java",
+//	                       "description": "run the bundled JDK"}}}
+type bundleConfig struct {
+	Commands map[string]commandConfig `json:"commands,omitempty"`
+	Audit    *auditConfig             `json:"audit,omitempty"`
+}
+
+// auditConfig turns on the audit log of the runtime. Arguments matching
+// a Redact pattern are masked, keeping the first submatch.
+type auditConfig struct {
+	Redact  []string `json:"redact,omitempty"`
+	MaxSize int64    `json:"max_size,omitempty"`
+}
+
+type commandConfig struct {
+	Env   map[string]string `json:"env,omitempty"`
+	Unset []string          `json:"unset,omitempty"`
+	Dir   string            `json:"dir,omitempty"`
+	Mode  string            `json:"mode,omitempty"`
+
+	// Description and Group are shown by sushibox -help.
+	Description string `json:"description,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+var envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func loadConfig(path string) (*bundleConfig, error) {
+	cfg := &bundleConfig{}
+	if path == "" {
+		return cfg, nil
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// validate checks that every configured command is in bin/ and every
+// variable name is usable.
+func (cfg *bundleConfig) validate(entries []manifestEntry) error {
+	commands := make(map[string]bool)
+	for _, e := range entries {
+		if e.Type != entryDir {
+			commands[e.Name] = true
+		}
+	}
+	names := make([]string, 0, len(cfg.Commands))
+	for name := range cfg.Commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !commands["bin/"+name] {
+			return fmt.Errorf("command %q is not in bin/", name)
+		}
+		c := cfg.Commands[name]
+		for key := range c.Env {
+			if !envName.MatchString(key) || key == "SUSHIBOX_ROOT" {
+				return fmt.Errorf("command %q: cannot set %q", name, key)
+			}
+		}
+		if c.Mode != "" && c.Mode != "exec" && c.Mode != "supervise" {
+			return fmt.Errorf("command %q: unknown mode %q", name, c.Mode)
+		}
+		for _, key := range c.Unset {
+			if !envName.MatchString(key) {
+				return fmt.Errorf("command %q: cannot unset %q", name, key)
+			}
+		}
+	}
+	if cfg.Audit != nil {
+		for _, pattern := range cfg.Audit.Redact {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("audit: invalid redact pattern: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// auditJSON is what the runtime reads the audit settings from, "" when
+// the audit log is off.
+func (cfg *bundleConfig) auditJSON() (string, error) {
+	if cfg.Audit == nil {
+		return "", nil
+	}
+	buf, err := json.Marshal(cfg.Audit)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// commandsJSON is what the runtime reads the per-command settings from.
+func (cfg *bundleConfig) commandsJSON() (string, error) {
+	if len(cfg.Commands) == 0 {
+		return "", nil
+	}
+	buf, err := json.Marshal(cfg.Commands)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+
+	path := filepath.Join(input, "sushibox.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"commands": {"python": {
+		"env": {"PYTHONHOME": "${SUSHIBOX_ROOT}"}, "unset": ["PYTHONPATH"], "dir": "var"}}}`), os.FileMode(0644)))
+	cfg, err := loadConfig(path)
+	assert.Nil(t, err)
+	assert.Nil(t, cfg.validate(entries))
+	commands, err := cfg.commandsJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, `{"python":{"env":{"PYTHONHOME":"${SUSHIBOX_ROOT}"},"unset":["PYTHONPATH"],"dir":"var"}}`, commands)
+
+	cfg, err = loadConfig("")
+	assert.Nil(t, err)
+	commands, err = cfg.commandsJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, "", commands)
+}
+
+func TestConfigValidate(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+
+	for _, cfg := range []bundleConfig{
+		{Commands: map[string]commandConfig{"ruby": {}}},
+		{Commands: map[string]commandConfig{"python": {Env: map[string]string{"A=B": "c"}}}},
+		{Commands: map[string]commandConfig{"python": {Env: map[string]string{"SUSHIBOX_ROOT": "/"}}}},
+		{Commands: map[string]commandConfig{"python": {Unset: []string{""}}}},
+		{Commands: map[string]commandConfig{"python": {Mode: "fork"}}},
+	} {
+		assert.NotNil(t, cfg.validate(entries), cfg)
+	}
+}
+
+func TestConfigAudit(t *testing.T) {
+	cfg := &bundleConfig{}
+	audit, err := cfg.auditJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, "", audit)
+
+	cfg.Audit = &auditConfig{Redact: []string{"(--password=).*"}}
+	assert.Nil(t, cfg.validate(nil))
+	audit, err = cfg.auditJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, `{"redact":["(--password=).*"]}`, audit)
+
+	cfg.Audit.Redact = []string{"("}
+	assert.NotNil(t, cfg.validate(nil))
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+var encrypt = flag.Bool("encrypt", false, "encrypt asset contents with a key derived from a passphrase")
+var keyFile = flag.String("key-file", "", "read the encryption passphrase from this file instead of $"+keyEnv+" or a prompt")
+
+const keyEnv = "SUSHIMASTER_KEY"
+
+// Must match the runtime in sushibox/crypt.go.
+const kdfIterations = 200000
+
+// readPassphrase returns the passphrase from -key-file, $SUSHIMASTER_KEY
+// or, as a last resort, the terminal.
+func readPassphrase() (string, error) {
+	if *keyFile != "" {
+		buf, err := ioutil.ReadFile(*keyFile)
+		if err != nil {
+			return "", err
+		}
+		passphrase := strings.TrimRight(string(buf), "\r\n")
+		if passphrase == "" {
+			return "", fmt.Errorf("empty passphrase in %s", *keyFile)
+		}
+		return passphrase, nil
+	}
+	if key := os.Getenv(keyEnv); key != "" {
+		return key, nil
+	}
+
+	first, err := promptPassphrase("Passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	second, err := promptPassphrase("Confirm passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if first != second {
+		return "", fmt.Errorf("passphrases do not match")
+	}
+	return first, nil
+}
+
+func promptPassphrase(prompt string) (string, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("no passphrase given and no terminal to prompt on: %v", err)
+	}
+	defer tty.Close()
+
+	stty := func(args ...string) {
+		cmd := exec.Command("stty", args...)
+		cmd.Stdin = tty
+		cmd.Run()
+	}
+	stty("-echo")
+	defer stty("echo")
+
+	fmt.Fprint(tty, prompt)
+	line, err := bufio.NewReader(tty).ReadString('\n')
+	fmt.Fprintln(tty)
+	if err != nil {
+		return "", err
+	}
+	passphrase := strings.TrimRight(line, "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("empty passphrase")
+	}
+	return passphrase, nil
+}
+
+// newEncryptor derives an AES-256-GCM key from the passphrase and a fresh
+// salt. Each asset is sealed with a random nonce prepended and its name as
+// additional data, so that assets cannot be swapped for each other.
+func newEncryptor() (encryptor func(name string, data []byte) ([]byte, error), salt string, err error) {
+	if !*encrypt {
+		return
+	}
+	passphrase, err := readPassphrase()
+	if err != nil {
+		return
+	}
+	saltBytes := make([]byte, 16)
+	if _, err = rand.Read(saltBytes); err != nil {
+		return
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, saltBytes, kdfIterations, 32)
+	if err != nil {
+		return
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return
+	}
+
+	encryptor = func(name string, data []byte) ([]byte, error) {
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		return gcm.Seal(nonce, nonce, data, []byte(name)), nil
+	}
+	salt = base64.StdEncoding.EncodeToString(saltBytes)
+	return
+}
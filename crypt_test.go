@@ -0,0 +1,94 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewEncryptor(t *testing.T) {
+	f, err := ioutil.TempFile("", "sushimaster_key_")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString("secret\n")
+	f.Close()
+
+	*encrypt, *keyFile = true, f.Name()
+	defer func() { *encrypt, *keyFile = false, "" }()
+
+	encryptor, salt, err := newEncryptor()
+	assert.Nil(t, err)
+	sealed, err := encryptor("bin/foo", []byte("foo"))
+	assert.Nil(t, err)
+
+	saltBytes, _ := base64.StdEncoding.DecodeString(salt)
+	key, _ := pbkdf2.Key(sha256.New, "secret", saltBytes, kdfIterations, 32)
+	block, _ := aes.NewCipher(key)
+	gcm, _ := cipher.NewGCM(block)
+	n := gcm.NonceSize()
+	plain, err := gcm.Open(nil, sealed[:n], sealed[n:], []byte("bin/foo"))
+	assert.Nil(t, err)
+	assert.Equal(t, "foo", string(plain))
+
+	_, err = gcm.Open(nil, sealed[:n], sealed[n:], []byte("bin/bar"))
+	assert.NotNil(t, err)
+}
+
+func TestNewEncryptorEmptyKeyFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "sushimaster_key_")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString("\n")
+	f.Close()
+
+	*encrypt, *keyFile = true, f.Name()
+	defer func() { *encrypt, *keyFile = false, "" }()
+
+	_, _, err = newEncryptor()
+	assert.NotNil(t, err)
+}
+
+func TestNewEncryptorDisabled(t *testing.T) {
+	encryptor, salt, err := newEncryptor()
+	assert.Nil(t, err)
+	assert.Nil(t, encryptor)
+	assert.Equal(t, "", salt)
+}
+
+func TestStageInput(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "sushimaster_stage_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	rename := func(name string, data []byte) ([]byte, error) {
+		return []byte(name), nil
+	}
+	assert.Nil(t, stageInput(dir, input, entries, rename))
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "bin", "python3"))
+	assert.Nil(t, err)
+	assert.Equal(t, "bin/python3", string(data))
+
+	src, _ := os.Stat(filepath.Join(input, "bin", "python3"))
+	dst, _ := os.Stat(filepath.Join(dir, "bin", "python3"))
+	assert.Equal(t, src.Mode(), dst.Mode())
+	assert.Equal(t, src.ModTime().Unix(), dst.ModTime().Unix())
+
+	_, err = os.Lstat(filepath.Join(dir, "bin", "python"))
+	assert.True(t, os.IsNotExist(err))
+	info, err := os.Stat(filepath.Join(dir, "var", "log"))
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir())
+}
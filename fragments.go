@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A file generated by go-bindata is split into one fragment per asset,
+// holding the declarations of that asset, and a prelude holding the rest,
+// with markers where the assets, the entries of the _bindata table and
+// the _bintree go. assembleBindata puts them back together in name order.
+const (
+	assetsMarker = "//sushimaster:assets"
+	tableMarker  = "//sushimaster:table"
+	treeMarker   = "//sushimaster:tree "
+)
+
+type span struct {
+	start, end int
+	key        string
+}
+
+// bindataLayout locates the parts of a file generated by go-bindata.
+type bindataLayout struct {
+	funcs    map[string]string // asset func -> asset name
+	decls    []span            // per-asset declarations, keyed by asset name
+	table    span              // between the braces of the _bindata table
+	tree     span              // the _bintree declaration
+	treeType string
+}
+
+func parseBindata(path string, src []byte) (*bindataLayout, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	offset := func(p token.Pos) int {
+		return fset.Position(p).Offset
+	}
+
+	layout := &bindataLayout{funcs: make(map[string]string)}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR || len(gen.Specs) != 1 {
+			continue
+		}
+		vs := gen.Specs[0].(*ast.ValueSpec)
+		if len(vs.Names) != 1 || len(vs.Values) != 1 {
+			continue
+		}
+		switch vs.Names[0].Name {
+		case "_bindata":
+			// The asset functions are the values of the _bindata table.
+			lit, ok := vs.Values[0].(*ast.CompositeLit)
+			if !ok {
+				return nil, fmt.Errorf("unexpected _bindata table in %s", path)
+			}
+			layout.table = span{offset(lit.Lbrace) + 1, offset(lit.Rbrace), ""}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					return nil, fmt.Errorf("unexpected _bindata entry in %s", path)
+				}
+				key, _ := kv.Key.(*ast.BasicLit)
+				value, _ := kv.Value.(*ast.Ident)
+				if key == nil || value == nil {
+					return nil, fmt.Errorf("unexpected _bindata entry in %s", path)
+				}
+				name, err := strconv.Unquote(key.Value)
+				if err != nil {
+					return nil, err
+				}
+				layout.funcs[value.Name] = name
+			}
+		case "_bintree":
+			unary, _ := vs.Values[0].(*ast.UnaryExpr)
+			if unary == nil {
+				return nil, fmt.Errorf("unexpected _bintree in %s", path)
+			}
+			lit, _ := unary.X.(*ast.CompositeLit)
+			if lit == nil {
+				return nil, fmt.Errorf("unexpected _bintree in %s", path)
+			}
+			typ, _ := lit.Type.(*ast.Ident)
+			if typ == nil {
+				return nil, fmt.Errorf("unexpected _bintree in %s", path)
+			}
+			layout.tree = span{offset(decl.Pos()), offset(decl.End()), ""}
+			layout.treeType = typ.Name
+		}
+	}
+	if layout.table.end == 0 || layout.tree.end == 0 {
+		return nil, fmt.Errorf("no _bindata table or _bintree in %s", path)
+	}
+
+	for _, decl := range file.Decls {
+		var ident string
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok == token.VAR && len(d.Specs) == 1 {
+				if vs := d.Specs[0].(*ast.ValueSpec); len(vs.Names) == 1 {
+					ident = strings.TrimPrefix(vs.Names[0].Name, "_")
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				ident = strings.TrimSuffix(d.Name.Name, "_bytes")
+			}
+		}
+		if name, ok := layout.funcs[ident]; ok {
+			layout.decls = append(layout.decls, span{offset(decl.Pos()), offset(decl.End()), name})
+		}
+	}
+	return layout, nil
+}
+
+var unsafeIdent = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// assetIdent names the functions of an asset. go-bindata numbers the
+// names of assets which would get the same one, which only works within
+// one run, so fragments from different runs are renamed.
+func assetIdent(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return "asset_" + unsafeIdent.ReplaceAllString(name, "_") + "_" + hex.EncodeToString(sum[:4])
+}
+
+// renameIdents replaces the identifiers in src found in names.
+func renameIdents(src []byte, names map[string]string) []byte {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, src, nil, scanner.ScanComments)
+
+	var out bytes.Buffer
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if to, ok := names[lit]; ok && tok == token.IDENT {
+			start := file.Offset(pos)
+			out.Write(src[last:start])
+			out.WriteString(to)
+			last = start + len(lit)
+		}
+	}
+	out.Write(src[last:])
+	return out.Bytes()
+}
+
+// splitBindata splits a file generated by go-bindata into its prelude and
+// the fragments of its assets, by asset name.
+func splitBindata(path string, src []byte) ([]byte, map[string][]byte, error) {
+	layout, err := parseBindata(path, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	funcOf := make(map[string]string)
+	for f, name := range layout.funcs {
+		funcOf[name] = f
+	}
+	fragments := make(map[string][]byte)
+	for _, d := range layout.decls {
+		f, ident := funcOf[d.key], assetIdent(d.key)
+		text := renameIdents(src[d.start:d.end], map[string]string{
+			f: ident, f + "_bytes": ident + "_bytes", "_" + f: "_" + ident,
+		})
+		fragments[d.key] = append(fragments[d.key], append(text, "\n\n"...)...)
+	}
+
+	// The declarations of the assets are replaced by one marker, the
+	// table and the tree by one each.
+	type cut struct {
+		start, end int
+		text       string
+		asset      bool
+	}
+	cuts := []cut{
+		{layout.table.start, layout.table.end, "\n" + tableMarker + "\n", false},
+		{layout.tree.start, layout.tree.end, treeMarker + layout.treeType, false},
+	}
+	for _, d := range layout.decls {
+		cuts = append(cuts, cut{d.start, d.end, "", true})
+	}
+	sort.Slice(cuts, func(i, j int) bool {
+		return cuts[i].start < cuts[j].start
+	})
+
+	var prelude bytes.Buffer
+	last, assets := 0, false
+	for _, c := range cuts {
+		prelude.Write(src[last:c.start])
+		last = c.end
+		switch {
+		case !c.asset:
+			prelude.WriteString(c.text)
+		case !assets:
+			prelude.WriteString(assetsMarker)
+			assets = true
+		}
+	}
+	prelude.Write(src[last:])
+	if !assets {
+		return nil, nil, fmt.Errorf("no assets in %s", path)
+	}
+	return prelude.Bytes(), fragments, nil
+}
+
+// assembleBindata puts a prelude and the fragments of the given assets
+// together, ordered by name so that the result does not depend on the
+// order files were read in.
+func assembleBindata(prelude []byte, fragments map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(fragments))
+	for name := range fragments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	src := string(prelude)
+	i := strings.Index(src, treeMarker)
+	if i < 0 || !strings.Contains(src, assetsMarker) || !strings.Contains(src, tableMarker) {
+		return nil, fmt.Errorf("invalid bindata prelude")
+	}
+	treeType := src[i+len(treeMarker):]
+	if j := strings.Index(treeType, "\n"); j >= 0 {
+		treeType = treeType[:j]
+	}
+
+	var assets, table bytes.Buffer
+	tree := make(map[string]interface{})
+	for _, name := range names {
+		assets.Write(fragments[name])
+		fmt.Fprintf(&table, "\t%s: %s,\n", strconv.Quote(name), assetIdent(name))
+
+		node := tree
+		parts := strings.Split(name, "/")
+		for _, p := range parts[:len(parts)-1] {
+			child, ok := node[p].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				node[p] = child
+			}
+			node = child
+		}
+		node[parts[len(parts)-1]] = assetIdent(name)
+	}
+
+	var bintree bytes.Buffer
+	fmt.Fprintf(&bintree, "var _bintree = &%s{nil, map[string]*%s{\n", treeType, treeType)
+	writeBintree(&bintree, tree, treeType, 1)
+	fmt.Fprintf(&bintree, "}}")
+
+	src = strings.Replace(src, assetsMarker, assets.String(), 1)
+	src = strings.Replace(src, tableMarker+"\n", table.String(), 1)
+	src = strings.Replace(src, treeMarker+treeType, bintree.String(), 1)
+	return []byte(src), nil
+}
+
+func writeBintree(b *bytes.Buffer, node map[string]interface{}, treeType string, depth int) {
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	indent := strings.Repeat("\t", depth)
+	for _, key := range keys {
+		switch child := node[key].(type) {
+		case string:
+			fmt.Fprintf(b, "%s%s: &%s{%s, map[string]*%s{}},\n", indent, strconv.Quote(key), treeType, child, treeType)
+		case map[string]interface{}:
+			fmt.Fprintf(b, "%s%s: &%s{nil, map[string]*%s{\n", indent, strconv.Quote(key), treeType, treeType)
+			writeBintree(b, child, treeType, depth+1)
+			fmt.Fprintf(b, "%s}},\n", indent)
+		}
+	}
+}
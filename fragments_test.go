@@ -0,0 +1,83 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// translateFiles runs go-bindata on a directory holding the given files.
+func translateFiles(t *testing.T, files map[string]string) []byte {
+	dir, err := ioutil.TempDir("", "sushimaster_test_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	input := filepath.Join(dir, "input")
+	for name, content := range files {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(input, filepath.FromSlash(name))), os.FileMode(0755)))
+		writeScript(t, input, name, content)
+	}
+	output := filepath.Join(dir, "bindata.go")
+	assert.Nil(t, translateBindata(output, input))
+	buf, err := ioutil.ReadFile(output)
+	assert.Nil(t, err)
+	return buf
+}
+
+func TestSplitBindata(t *testing.T) {
+	// go-bindata numbers the functions of bin/a.b and bin/a_b.
+	src := translateFiles(t, map[string]string{"bin/a.b": "#!/bin/sh\n", "bin/a_b": "#!/bin/sh\n", "share/doc": "doc"})
+	prelude, fragments, err := splitBindata("bindata.go", src)
+	assert.Nil(t, err)
+	assert.Contains(t, string(prelude), assetsMarker)
+	assert.Contains(t, string(prelude), tableMarker)
+	assert.Contains(t, string(prelude), treeMarker)
+	assert.NotContains(t, string(prelude), "bin_a")
+
+	assert.Equal(t, 3, len(fragments))
+	for name, fragment := range fragments {
+		ident := assetIdent(name)
+		assert.Contains(t, string(fragment), "var _"+ident+" =")
+		assert.Contains(t, string(fragment), "func "+ident+"(")
+	}
+	assert.NotEqual(t, assetIdent("bin/a.b"), assetIdent("bin/a_b"))
+
+	_, _, err = splitBindata("bindata.go", []byte("package main\n"))
+	assert.NotNil(t, err)
+}
+
+func TestAssembleBindata(t *testing.T) {
+	prelude, fragments, err := splitBindata("bindata.go", translateFiles(t, map[string]string{"bin/tool": "#!/bin/sh\n", "share/doc": "doc"}))
+	assert.Nil(t, err)
+
+	// Fragments of separate runs go together.
+	_, changed, err := splitBindata("bindata.go", translateFiles(t, map[string]string{"bin/tool": "#!/bin/sh\necho\n", "bin/a": "#!/bin/sh\n"}))
+	assert.Nil(t, err)
+	fragments["bin/tool"] = changed["bin/tool"]
+	fragments["bin/a"] = changed["bin/a"]
+
+	src, err := assembleBindata(prelude, fragments)
+	assert.Nil(t, err)
+	_, err = parser.ParseFile(token.NewFileSet(), "bindata.go", src, 0)
+	assert.Nil(t, err)
+
+	s := string(src)
+	a := strings.Index(s, "var _"+assetIdent("bin/a")+" =")
+	tool := strings.Index(s, "var _"+assetIdent("bin/tool")+" =")
+	doc := strings.Index(s, "var _"+assetIdent("share/doc")+" =")
+	assert.True(t, a >= 0 && a < tool && tool < doc)
+	assert.Contains(t, s, `"bin/tool": `+assetIdent("bin/tool")+",")
+	assert.Contains(t, s, `"share": &`)
+	assert.Equal(t, 1, strings.Count(s, "var _"+assetIdent("bin/tool")+" ="))
+
+	again, err := assembleBindata(prelude, fragments)
+	assert.Nil(t, err)
+	assert.Equal(t, src, again)
+
+	_, err = assembleBindata([]byte("package main\n"), fragments)
+	assert.NotNil(t, err)
+}
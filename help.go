@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// descriptionHeader starts the comment which describes a script in bin/,
+// e.g. "# sushibox: convert access logs to CSV". It is looked for in the
+// first maxDescriptionLines lines of files starting with a shebang.
+const descriptionHeader = "# sushibox:"
+
+const maxDescriptionLines = 20
+
+// readDescription returns the description header of src, or "".
+func readDescription(src string) (string, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(io.LimitReader(f, 64*1024))
+	for i := 0; i < maxDescriptionLines && scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if i == 0 && !strings.HasPrefix(line, "#!") {
+			return "", nil
+		}
+		if strings.HasPrefix(line, descriptionHeader) {
+			return strings.TrimSpace(strings.TrimPrefix(line, descriptionHeader)), nil
+		}
+	}
+	if err := scanner.Err(); err != nil && err != bufio.ErrTooLong {
+		return "", err
+	}
+	return "", nil
+}
+
+// applyDescriptions records a description and group for each command in
+// bin/. A description in -config wins over the header of the script.
+func applyDescriptions(input string, entries []manifestEntry, cfg *bundleConfig) error {
+	for i, e := range entries {
+		if path.Dir(e.Name) != "bin" || e.Type == entryDir {
+			continue
+		}
+		c := cfg.Commands[path.Base(e.Name)]
+		entries[i].Description, entries[i].Group = c.Description, c.Group
+		if c.Description != "" || e.Type != entryFile {
+			continue
+		}
+		src := filepath.Join(input, filepath.FromSlash(e.Name))
+		if e.source != "" {
+			src = e.source
+		}
+		description, err := readDescription(src)
+		if err != nil {
+			return err
+		}
+		entries[i].Description = description
+	}
+	return nil
+}
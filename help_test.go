@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadDescription(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	writeScript(t, input, "bin/foo", "#!/bin/sh\n# Copyright\n# sushibox:  convert logs to CSV \necho\n")
+	writeScript(t, input, "bin/bar", "#!/bin/sh\necho bar\n")
+	writeScript(t, input, "bin/baz", "# sushibox: not a script\n")
+
+	for name, expected := range map[string]string{"foo": "convert logs to CSV", "bar": "", "baz": ""} {
+		description, err := readDescription(filepath.Join(input, "bin", name))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, description, name)
+	}
+}
+
+func TestApplyDescriptions(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	writeScript(t, input, "bin/foo", "#!/bin/sh\n# sushibox: from header\n")
+	writeScript(t, input, "bin/bar", "#!/bin/sh\n# sushibox: from header\n")
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	cfg := &bundleConfig{Commands: map[string]commandConfig{
+		"bar": {Description: "from config", Group: "Tools"},
+	}}
+	assert.Nil(t, applyDescriptions(input, entries, cfg))
+
+	byName := make(map[string]manifestEntry)
+	for _, e := range entries {
+		byName[e.Name] = e
+	}
+	assert.Equal(t, "from header", byName["bin/foo"].Description)
+	assert.Equal(t, "", byName["bin/foo"].Group)
+	assert.Equal(t, "from config", byName["bin/bar"].Description)
+	assert.Equal(t, "Tools", byName["bin/bar"].Group)
+}
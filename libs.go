@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bufio"
+	"debug/elf"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var bundleLibs = flag.Bool("bundle-libs", false, "bundle the shared libraries needed by ELF executables in bin/ into lib/")
+
+// libDir is where bundled libraries go and what the runtime puts on
+// LD_LIBRARY_PATH.
+const libDir = "lib"
+
+// systemLibs have to match the dynamic loader of the host, so they are
+// never bundled.
+var systemLibs = regexp.MustCompile(`^(ld-linux.*|ld64|libc|libm|libdl|libpthread|librt|libresolv|libutil)\.so(\.|$)`)
+
+// ldSoConf lists the loader's search directories, used after RPATH,
+// LD_LIBRARY_PATH and RUNPATH.
+var ldSoConf = "/etc/ld.so.conf"
+
+// libRoot is the root the default search directories are under.
+var libRoot = "/"
+
+var multiarch = map[elf.Machine]string{
+	elf.EM_X86_64:  "x86_64-linux-gnu",
+	elf.EM_386:     "i386-linux-gnu",
+	elf.EM_AARCH64: "aarch64-linux-gnu",
+	elf.EM_ARM:     "arm-linux-gnueabihf",
+}
+
+// bundledLibDir tells the runtime where to find bundled libraries.
+func bundledLibDir() string {
+	if *bundleLibs {
+		return libDir
+	}
+	return ""
+}
+
+type libReport struct {
+	bundled    map[string]string
+	skipped    map[string]bool
+	unresolved map[string][]string
+}
+
+type elfObject struct {
+	name   string
+	path   string
+	class  elf.Class
+	mach   elf.Machine
+	needed []string
+	rpath  []string
+}
+
+func openELF(name, src string) (*elfObject, error) {
+	f, err := elf.Open(src)
+	if err != nil {
+		return nil, nil // not an ELF file
+	}
+	defer f.Close()
+
+	needed, err := f.ImportedLibraries()
+	if err != nil {
+		return nil, fmt.Errorf("read DT_NEEDED of %s: %v", name, err)
+	}
+	obj := &elfObject{name: name, path: src, class: f.Class, mach: f.Machine, needed: needed}
+
+	// DT_RPATH is only honoured when there is no DT_RUNPATH, which in turn
+	// comes after LD_LIBRARY_PATH.
+	runpath, _ := f.DynString(elf.DT_RUNPATH)
+	rpath, _ := f.DynString(elf.DT_RPATH)
+	var dirs []string
+	if len(runpath) == 0 {
+		dirs = append(dirs, splitPath(rpath)...)
+	}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("LD_LIBRARY_PATH"))...)
+	dirs = append(dirs, splitPath(runpath)...)
+	origin := filepath.Dir(src)
+	for _, d := range dirs {
+		d = strings.Replace(d, "${ORIGIN}", origin, -1)
+		d = strings.Replace(d, "$ORIGIN", origin, -1)
+		obj.rpath = append(obj.rpath, d)
+	}
+	return obj, nil
+}
+
+func splitPath(list []string) (dirs []string) {
+	for _, l := range list {
+		for _, d := range strings.Split(l, ":") {
+			if d != "" {
+				dirs = append(dirs, d)
+			}
+		}
+	}
+	return
+}
+
+// loaderDirs returns the directories listed in ld.so.conf and its
+// includes, followed by the default ones for the architecture.
+func loaderDirs(mach elf.Machine, class elf.Class) []string {
+	var dirs []string
+	readLdSoConf(ldSoConf, &dirs, 0)
+
+	if triplet, ok := multiarch[mach]; ok {
+		dirs = append(dirs, filepath.Join(libRoot, "lib", triplet), filepath.Join(libRoot, "usr", "lib", triplet))
+	}
+	if class == elf.ELFCLASS64 {
+		dirs = append(dirs, filepath.Join(libRoot, "lib64"), filepath.Join(libRoot, "usr", "lib64"))
+	}
+	return append(dirs, filepath.Join(libRoot, "lib"), filepath.Join(libRoot, "usr", "lib"))
+}
+
+func readLdSoConf(conf string, dirs *[]string, depth int) {
+	if depth > 8 {
+		return
+	}
+	f, err := os.Open(conf)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "include ") {
+			pattern := strings.TrimSpace(strings.TrimPrefix(line, "include "))
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(filepath.Dir(conf), pattern)
+			}
+			matches, _ := filepath.Glob(pattern)
+			sort.Strings(matches)
+			for _, m := range matches {
+				readLdSoConf(m, dirs, depth+1)
+			}
+			continue
+		}
+		*dirs = append(*dirs, line)
+	}
+}
+
+// resolveLib finds soname the way the loader would for obj, only
+// accepting libraries of the same class and machine.
+func resolveLib(obj *elfObject, soname string) string {
+	for _, dir := range append(obj.rpath, loaderDirs(obj.mach, obj.class)...) {
+		candidate := filepath.Join(dir, soname)
+		f, err := elf.Open(candidate)
+		if err != nil {
+			continue
+		}
+		ok := f.Class == obj.class && f.Machine == obj.mach
+		f.Close()
+		if ok {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// collectLibs resolves the DT_NEEDED entries of every ELF file under bin/,
+// recursively, and returns manifest entries for the libraries to bundle
+// under lib/. Libraries the input already has under lib/ are left alone.
+func collectLibs(input string, entries []manifestEntry) ([]manifestEntry, *libReport, error) {
+	report := &libReport{
+		bundled:    make(map[string]string),
+		skipped:    make(map[string]bool),
+		unresolved: make(map[string][]string),
+	}
+	provided := make(map[string]bool)
+	var queue []*elfObject
+	for _, e := range entries {
+		provided[e.Name] = true
+		if e.Type != entryFile || !strings.HasPrefix(e.Name, "bin/") {
+			continue
+		}
+		obj, err := openELF(e.Name, filepath.Join(input, filepath.FromSlash(e.Name)))
+		if err != nil {
+			return nil, nil, err
+		}
+		if obj != nil {
+			queue = append(queue, obj)
+		}
+	}
+
+	var libs []manifestEntry
+	for len(queue) > 0 {
+		obj := queue[0]
+		queue = queue[1:]
+		for _, soname := range obj.needed {
+			name := path.Join(libDir, soname)
+			switch {
+			case systemLibs.MatchString(soname):
+				report.skipped[soname] = true
+				continue
+			case provided[name]:
+				continue
+			}
+
+			src := resolveLib(obj, soname)
+			if src == "" {
+				report.unresolved[soname] = append(report.unresolved[soname], obj.name)
+				continue
+			}
+			resolved, err := filepath.EvalSymlinks(src)
+			if err != nil {
+				return nil, nil, err
+			}
+			entry, err := fileEntry(name, resolved)
+			if err != nil {
+				return nil, nil, err
+			}
+			libs = append(libs, entry)
+			provided[name] = true
+			report.bundled[soname] = src
+
+			lib, err := openELF(name, resolved)
+			if err != nil {
+				return nil, nil, err
+			}
+			if lib != nil {
+				queue = append(queue, lib)
+			}
+		}
+	}
+
+	if len(libs) > 0 && !provided[libDir] {
+		libs = append(libs, manifestEntry{Name: libDir, Type: entryDir, Mode: os.ModeDir | 0755})
+	}
+	return libs, report, nil
+}
+
+// fileEntry describes a file from outside of the input that gets staged
+// as name.
+func fileEntry(name, src string) (manifestEntry, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return manifestEntry{}, err
+	}
+	hash, err := fileHash(src)
+	if err != nil {
+		return manifestEntry{}, err
+	}
+	return manifestEntry{
+		Name:    name,
+		Type:    entryFile,
+		Mode:    info.Mode().Perm(),
+		Size:    info.Size(),
+		ModTime: info.ModTime().Unix(),
+		Hash:    hash,
+		source:  src,
+	}, nil
+}
+
+func (r *libReport) write(w io.Writer) {
+	var names []string
+	for soname := range r.bundled {
+		names = append(names, soname)
+	}
+	sort.Strings(names)
+	for _, soname := range names {
+		fmt.Fprintf(w, "bundled    %s from %s\n", soname, r.bundled[soname])
+	}
+
+	names = names[:0]
+	for soname := range r.skipped {
+		names = append(names, soname)
+	}
+	sort.Strings(names)
+	for _, soname := range names {
+		fmt.Fprintf(w, "system     %s\n", soname)
+	}
+
+	names = names[:0]
+	for soname := range r.unresolved {
+		names = append(names, soname)
+	}
+	sort.Strings(names)
+	for _, soname := range names {
+		fmt.Fprintf(w, "unresolved %s needed by %s\n", soname, strings.Join(r.unresolved[soname], ", "))
+	}
+}
+
+func (r *libReport) err() error {
+	if len(r.unresolved) > 0 {
+		return fmt.Errorf("%d shared libraries could not be resolved", len(r.unresolved))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// dynamicInput makes an input with a copy of a dynamically linked system
+// binary as bin/ls.
+func dynamicInput(t *testing.T) string {
+	f, err := elf.Open("/bin/ls")
+	if err != nil {
+		t.Skip("no ELF /bin/ls")
+	}
+	needed, _ := f.ImportedLibraries()
+	f.Close()
+	if len(needed) == 0 {
+		t.Skip("/bin/ls is not dynamically linked")
+	}
+
+	input := makeInput(t)
+	data, err := ioutil.ReadFile("/bin/ls")
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "bin", "ls"), data, os.FileMode(0755)))
+	return input
+}
+
+func TestCollectLibs(t *testing.T) {
+	input := dynamicInput(t)
+	defer os.RemoveAll(input)
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	libs, report, err := collectLibs(input, entries)
+	assert.Nil(t, err)
+	assert.Nil(t, report.err())
+	assert.True(t, report.skipped["libc.so.6"])
+
+	for _, e := range libs {
+		if e.Type == entryDir {
+			assert.Equal(t, libDir, e.Name)
+			continue
+		}
+		assert.Equal(t, entryFile, e.Type)
+		assert.NotEqual(t, "", e.source)
+		assert.NotEqual(t, "", e.Hash)
+		assert.False(t, systemLibs.MatchString(filepath.Base(e.Name)), e.Name)
+	}
+
+	var buf bytes.Buffer
+	report.write(&buf)
+	assert.Contains(t, buf.String(), "system     libc.so.6\n")
+}
+
+func TestCollectLibsUnresolved(t *testing.T) {
+	input := dynamicInput(t)
+	defer os.RemoveAll(input)
+
+	root, err := ioutil.TempDir("", "sushimaster_root_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+	defer func(conf, dir, env string) {
+		ldSoConf, libRoot = conf, dir
+		os.Setenv("LD_LIBRARY_PATH", env)
+	}(ldSoConf, libRoot, os.Getenv("LD_LIBRARY_PATH"))
+	ldSoConf, libRoot = filepath.Join(root, "ld.so.conf"), root
+	os.Unsetenv("LD_LIBRARY_PATH")
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	libs, report, err := collectLibs(input, entries)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(libs))
+	if len(report.unresolved) == 0 {
+		t.Skip("/bin/ls only needs system libraries")
+	}
+	assert.NotNil(t, report.err())
+	for _, users := range report.unresolved {
+		assert.Equal(t, []string{"bin/ls"}, users)
+	}
+}
+
+func TestReadLdSoConf(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sushimaster_conf_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "ld.so.conf.d"), os.FileMode(0755)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "ld.so.conf"), []byte("# comment\ninclude ld.so.conf.d/*.conf\n/opt/lib # trailing\n"), os.FileMode(0644)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "ld.so.conf.d", "a.conf"), []byte("/usr/local/lib\n"), os.FileMode(0644)))
+
+	var dirs []string
+	readLdSoConf(filepath.Join(dir, "ld.so.conf"), &dirs, 0)
+	assert.Equal(t, []string{"/usr/local/lib", "/opt/lib"}, dirs)
+}
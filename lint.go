@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// lintLargeFile is the size above which a file is reported, since
+// go-bindata turns every byte into Go source.
+const lintLargeFile = 64 << 20
+
+// runtimeFlags are the options sushibox parses itself, which commands
+// should not be named after.
+var runtimeFlags = []string{"version", "verify", "json", "audit", "self-update", "versions", "use", "completion", "complete", "help", "man", "shell"}
+
+type lintIssue struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+}
+
+// lintInput checks the input tree for problems that only show up when a
+// command is run from the bundle.
+func lintInput(input string, entries []manifestEntry) ([]lintIssue, error) {
+	var issues []lintIssue
+	add := func(severity, name, check, format string, a ...interface{}) {
+		issues = append(issues, lintIssue{severity, name, check, fmt.Sprintf(format, a...)})
+	}
+
+	hasBin := false
+	for _, e := range entries {
+		if e.Name == "bin" && e.Type == entryDir {
+			hasBin = true
+		}
+		if e.Type != entrySymlink && e.Mode.Perm()&0002 != 0 {
+			add(severityWarning, e.Name, "world-writable", "mode %s is world writable", e.Mode.Perm())
+		}
+		if e.Type == entryFile && e.Size > lintLargeFile {
+			add(severityWarning, e.Name, "large-file", "%d bytes are embedded in the binary", e.Size)
+		}
+		hook := isHook(e.Name)
+		if (path.Dir(e.Name) != "bin" && !hook) || e.Type == entryDir {
+			continue
+		}
+
+		cmd := path.Base(e.Name)
+		switch {
+		case hook:
+		case cmd == "sushibox" || strings.HasPrefix(cmd, "-"):
+			add(severityError, e.Name, "reserved-name", "command %q cannot be run through sushibox", cmd)
+		case isRuntimeFlag(cmd):
+			add(severityWarning, e.Name, "reserved-name", "command %q has the name of the sushibox option -%s", cmd, cmd)
+		}
+		if e.Type == entrySymlink {
+			continue
+		}
+		if e.Mode.Perm()&0111 == 0 {
+			// A hook that cannot run would stop every command.
+			severity := severityWarning
+			if hook {
+				severity = severityError
+			}
+			add(severity, e.Name, "not-executable", "mode %s is not executable", e.Mode.Perm())
+			continue
+		}
+
+		src := filepath.Join(input, filepath.FromSlash(e.Name))
+		if e.Type == entryHardlink {
+			src = filepath.Join(input, filepath.FromSlash(e.Target))
+		}
+		head, err := readHead(src, maxShebang)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case isBinary(head):
+		case !bytes.HasPrefix(head, []byte("#!")):
+			add(severityError, e.Name, "missing-shebang", "executable is neither ELF, Mach-O nor a script with #!")
+		case bytes.HasSuffix(bytes.SplitN(head, []byte("\n"), 2)[0], []byte("\r")):
+			add(severityError, e.Name, "crlf", "shebang line ends with CRLF")
+		}
+	}
+	if !hasBin {
+		add(severityError, "bin", "missing-bin", "bin directory does not exist under %s", input)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Path < issues[j].Path
+	})
+	return issues, nil
+}
+
+// hooks are run by sushibox around every command.
+var hooks = []string{"hooks/pre-exec", "hooks/post-exec"}
+
+func isHook(name string) bool {
+	for _, h := range hooks {
+		if h == name {
+			return true
+		}
+	}
+	return false
+}
+
+// binaryMagic are the magic numbers of ELF and of Mach-O, thin in either
+// byte order or universal.
+var binaryMagic = [][]byte{
+	[]byte("\x7fELF"),
+	{0xfe, 0xed, 0xfa, 0xce}, {0xce, 0xfa, 0xed, 0xfe},
+	{0xfe, 0xed, 0xfa, 0xcf}, {0xcf, 0xfa, 0xed, 0xfe},
+	{0xca, 0xfe, 0xba, 0xbe}, {0xbe, 0xba, 0xfe, 0xca},
+}
+
+func isBinary(head []byte) bool {
+	for _, magic := range binaryMagic {
+		if bytes.HasPrefix(head, magic) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRuntimeFlag(name string) bool {
+	for _, f := range runtimeFlags {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+func readHead(src string, n int64) ([]byte, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(io.LimitReader(f, n))
+}
+
+func lintErrors(issues []lintIssue) error {
+	n := 0
+	for _, issue := range issues {
+		if issue.Severity == severityError {
+			n++
+		}
+	}
+	if n > 0 {
+		return fmt.Errorf("lint found %d errors", n)
+	}
+	return nil
+}
+
+func writeLintText(w io.Writer, issues []lintIssue) {
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%-7s %s: %s (%s)\n", issue.Severity, issue.Path, issue.Message, issue.Check)
+	}
+}
+
+func writeLintJSON(w io.Writer, issues []lintIssue) error {
+	if issues == nil {
+		issues = []lintIssue{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(issues)
+}
+
+// lintMain implements `sushimaster lint [-format text|json] <dir>`.
+func lintMain(args []string) int {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	format := flags.String("format", "text", "output format, text or json")
+	flags.Usage = func() {
+		fmt.Printf("Usage: %s lint [options] <input directory>\n\n", filepath.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "Missing <input directory>\n\n")
+		flags.Usage()
+		return 1
+	}
+	input := flags.Arg(0)
+
+	entries, err := collectManifest(input)
+	if err != nil {
+		return errorExit("collectManifest failed by %+v", err)
+	}
+	issues, err := lintInput(input, entries)
+	if err != nil {
+		return errorExit("lintInput failed by %+v", err)
+	}
+	switch *format {
+	case "text":
+		writeLintText(os.Stdout, issues)
+	case "json":
+		if err := writeLintJSON(os.Stdout, issues); err != nil {
+			return errorExit("%+v", err)
+		}
+	default:
+		return errorExit("unknown -format %q", *format)
+	}
+	if lintErrors(issues) != nil {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func lintChecks(issues []lintIssue) map[string]string {
+	checks := make(map[string]string)
+	for _, issue := range issues {
+		checks[issue.Path+" "+issue.Check] = issue.Severity
+	}
+	return checks
+}
+
+func TestLintInput(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	writeScript(t, input, "bin/python3", "#!/bin/sh\necho python\n")
+	writeScript(t, input, "bin/dos", "#!/bin/sh\r\necho dos\r\n")
+	writeScript(t, input, "bin/version", "#!/bin/sh\n")
+	writeScript(t, input, "bin/sushibox", "#!/bin/sh\n")
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "bin", "data"), []byte("data"), os.FileMode(0644)))
+	assert.Nil(t, os.Chmod(filepath.Join(input, "var", "log"), os.FileMode(0757)))
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	issues, err := lintInput(input, entries)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"bin/data not-executable":    severityWarning,
+		"bin/dos crlf":               severityError,
+		"bin/sushibox reserved-name": severityError,
+		"bin/version reserved-name":  severityWarning,
+		"var/log world-writable":     severityWarning,
+	}, lintChecks(issues))
+	assert.NotNil(t, lintErrors(issues))
+}
+
+func TestLintInputMissingShebang(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	issues, err := lintInput(input, entries)
+	assert.Nil(t, err)
+	// bin/python3.11 is a hardlink to bin/python3, bin/python a symlink.
+	assert.Equal(t, map[string]string{
+		"bin/python3 missing-shebang":    severityError,
+		"bin/python3.11 missing-shebang": severityError,
+	}, lintChecks(issues))
+}
+
+func TestLintInputBinaries(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	for name, magic := range map[string]string{
+		"python3":   "\x7fELF\x02\x01",
+		"macho":     "\xcf\xfa\xed\xfe\x07\x00",
+		"macho32":   "\xfe\xed\xfa\xce\x00\x00",
+		"universal": "\xca\xfe\xba\xbe\x00\x00",
+	} {
+		writeScript(t, input, "bin/"+name, magic)
+	}
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	issues, err := lintInput(input, entries)
+	assert.Nil(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestLintInputMissingBin(t *testing.T) {
+	input, err := ioutil.TempDir("", "sushimaster_test_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(input)
+
+	issues, err := lintInput(input, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"bin missing-bin": severityError}, lintChecks(issues))
+}
+
+func TestWriteLint(t *testing.T) {
+	issues := []lintIssue{{severityWarning, "bin/data", "not-executable", "mode -rw-r--r-- is not executable"}}
+
+	var buf bytes.Buffer
+	writeLintText(&buf, issues)
+	assert.Equal(t, "warning bin/data: mode -rw-r--r-- is not executable (not-executable)\n", buf.String())
+
+	buf.Reset()
+	assert.Nil(t, writeLintJSON(&buf, issues))
+	var decoded []lintIssue
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, issues, decoded)
+
+	buf.Reset()
+	assert.Nil(t, writeLintJSON(&buf, nil))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestLintInputHooks(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	writeScript(t, input, "bin/python3", "#!/bin/sh\n")
+	assert.Nil(t, os.Mkdir(filepath.Join(input, "hooks"), os.FileMode(0755)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "hooks", "pre-exec"), []byte("#!/bin/sh\n"), os.FileMode(0644)))
+	writeScript(t, input, "hooks/post-exec", "echo done\n")
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	issues, err := lintInput(input, entries)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"hooks/post-exec missing-shebang": severityError,
+		"hooks/pre-exec not-executable":   severityError,
+	}, lintChecks(issues))
+}
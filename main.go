@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"github.com/jteeuwen/go-bindata"
@@ -9,43 +10,168 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "lint":
+			os.Exit(lintMain(os.Args[2:]))
+		case "publish":
+			os.Exit(publishMain(os.Args[2:]))
+		}
+	}
 	os.Exit(realMain())
 }
 
 func realMain() int {
-	workDir, err := ioutil.TempDir("", "sushimaster_")
+	input, output := parseArgs()
+
+	workDir, cleanup, err := openWorkDir()
 	if err != nil {
 		return errorExit("%+v", err)
 	}
-	defer func() {
-		os.RemoveAll(workDir)
-	}()
+	defer cleanup()
 
-	input, output := parseArgs()
+	if *watch {
+		return watchMain(workDir, input, output)
+	}
+	if _, err := build(workDir, input, output); err != nil {
+		return errorExit("%+v", err)
+	}
+	return 0
+}
+
+type buildResult struct {
+	version string
+	entries int
+}
+
+// build generates the sushibox sources for input in workDir and builds
+// them into output.
+func build(workDir, input, output string) (*buildResult, error) {
+	entries, err := collectManifest(input)
+	if err != nil {
+		return nil, fmt.Errorf("collectManifest failed by %+v", err)
+	}
+	issues, err := lintInput(input, entries)
+	if err != nil {
+		return nil, fmt.Errorf("lintInput failed by %+v", err)
+	}
+	writeLintText(os.Stderr, issues)
+	if err := lintErrors(issues); err != nil {
+		return nil, err
+	}
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return nil, fmt.Errorf("loadConfig failed by %+v", err)
+	}
+	if err := cfg.validate(entries); err != nil {
+		return nil, fmt.Errorf("loadConfig failed by %+v", err)
+	}
+	if err := applyDescriptions(input, entries, cfg); err != nil {
+		return nil, fmt.Errorf("applyDescriptions failed by %+v", err)
+	}
+	commands, err := cfg.commandsJSON()
+	if err != nil {
+		return nil, err
+	}
+	audit, err := cfg.auditJSON()
+	if err != nil {
+		return nil, err
+	}
+	if *bundleLibs {
+		libs, report, err := collectLibs(input, entries)
+		if err != nil {
+			return nil, fmt.Errorf("collectLibs failed by %+v", err)
+		}
+		report.write(os.Stderr)
+		if err := report.err(); err != nil {
+			return nil, fmt.Errorf("collectLibs failed by %+v", err)
+		}
+		entries = sortEntries(append(entries, libs...))
+	}
+	if *checkShebangs || *bundledInterpreters {
+		shebangs, err := collectShebangs(input, entries)
+		if err != nil {
+			return nil, fmt.Errorf("collectShebangs failed by %+v", err)
+		}
+		writeShebangs(os.Stderr, shebangs)
+		if *checkShebangs {
+			if err := checkInterpreters(shebangs); err != nil {
+				return nil, fmt.Errorf("checkInterpreters failed by %+v", err)
+			}
+		}
+		if *bundledInterpreters {
+			applyInterpreters(entries, shebangs)
+		}
+	}
+	if *reproducible && *encrypt {
+		return nil, fmt.Errorf("-reproducible cannot be combined with -encrypt")
+	}
+	if *reproducible {
+		epoch, err := sourceDateEpoch()
+		if err != nil {
+			return nil, fmt.Errorf("sourceDateEpoch failed by %+v", err)
+		}
+		normalizeModTimes(entries, epoch)
+	}
+
+	manifest, err := marshalManifest(entries)
+	if err != nil {
+		return nil, fmt.Errorf("marshalManifest failed by %+v", err)
+	}
+	version := makeVersion(manifest)
+	result := &buildResult{version: version, entries: len(entries)}
+	if *dryRun {
+		writePlan(os.Stdout, entries, version, output)
+		return result, nil
+	}
+	if *verbose {
+		writePlan(os.Stderr, entries, version, output)
+	}
 
-	err = writeAssets(workDir)
+	encryptor, salt, err := newEncryptor()
 	if err != nil {
-		return errorExit("writeAssets failed by %+v", err)
+		return nil, fmt.Errorf("newEncryptor failed by %+v", err)
 	}
-	err = writeBindata(workDir, input)
+	info, err := makeBuildInfo(input, entries, version, manifest)
 	if err != nil {
-		return errorExit("writeBindata failed by %+v", err)
+		return nil, fmt.Errorf("makeBuildInfo failed by %+v", err)
+	}
+	if err := cleanWorkDir(workDir); err != nil {
+		return nil, fmt.Errorf("cleanWorkDir failed by %+v", err)
+	}
+	err = writeAssets(workDir, entries, assetValues{
+		version:  version,
+		salt:     salt,
+		info:     info,
+		commands: commands,
+		audit:    audit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("writeAssets failed by %+v", err)
+	}
+
+	err = regenerateBindata(workDir, input, salt, entries, encryptor)
+	if err != nil {
+		return nil, fmt.Errorf("regenerateBindata failed by %+v", err)
 	}
 	err = buildSushibox(workDir, output)
 	if err != nil {
-		return errorExit("buildSushibox failed by %+v", err)
+		return nil, fmt.Errorf("buildSushibox failed by %+v", err)
 	}
-	return 0
+	return result, nil
 }
 
 func parseArgs() (input, output string) {
 	flag.Usage = func() {
-		fmt.Printf("Usage: %s [options] <input directory>\n\n", filepath.Base(os.Args[0]))
+		fmt.Printf("Usage: %s [options] <input directory>\n", filepath.Base(os.Args[0]))
+		fmt.Printf("       %s lint [options] <input directory>\n", filepath.Base(os.Args[0]))
+		fmt.Printf("       %s publish -sign-key <key> <release directory> <sushibox>\n\n", filepath.Base(os.Args[0]))
 		flag.PrintDefaults()
 	}
 
@@ -63,55 +189,130 @@ func parseArgs() (input, output string) {
 	return
 }
 
-func makeVersion() string {
+// timestampVersion is the layout of build versions which are not the
+// payload hash of a reproducible build.
+const timestampVersion = "20060102-150405"
+
+func makeVersion(manifest string) string {
+	if *reproducible {
+		return payloadHash(manifest)[:16]
+	}
 	t := time.Now()
-	return t.Format("20060102-150405")
+	return t.Format(timestampVersion)
 }
 
-func writeAssets(workDir string) error {
-	sushibox_go, err := Asset("sushibox.go")
+// assetValues are substituted for the placeholders in the runtime sources.
+type assetValues struct {
+	version  string
+	salt     string
+	info     string
+	commands string
+	audit    string
+}
+
+func writeAssets(workDir string, entries []manifestEntry, values assetValues) error {
+	manifest, err := marshalManifest(entries)
 	if err != nil {
 		return err
 	}
-
-	version_go, err := Asset("version.go")
+	signature, publicKey, err := signManifest(manifest)
 	if err != nil {
 		return err
 	}
 
-	version := makeVersion()
-	version_go = []byte(strings.Replace(string(version_go), "developing", version, 1))
-
-	err = ioutil.WriteFile(filepath.Join(workDir, "sushibox.go"), sushibox_go, os.FileMode(0644))
-	if err != nil {
-		return err
+	replacers := map[string]*strings.Replacer{
+		"version.go": strings.NewReplacer(
+			`"developing"`, strconv.Quote(values.version),
+			`var PayloadHash = ""`, "var PayloadHash = "+strconv.Quote(payloadHash(manifest)),
+		),
+		"manifest.go": strings.NewReplacer(`var manifestJSON = ""`, "var manifestJSON = "+strconv.Quote(manifest)),
+		"verify.go": strings.NewReplacer(
+			`var manifestSignature = ""`, "var manifestSignature = "+strconv.Quote(signature),
+			`var signingKey = ""`, "var signingKey = "+strconv.Quote(publicKey),
+		),
+		"crypt.go":     strings.NewReplacer(`var encryptionSalt = ""`, "var encryptionSalt = "+strconv.Quote(values.salt)),
+		"libs.go":      strings.NewReplacer(`var libDir = ""`, "var libDir = "+strconv.Quote(bundledLibDir())),
+		"buildinfo.go": strings.NewReplacer(`var buildInfoJSON = ""`, "var buildInfoJSON = "+strconv.Quote(values.info)),
+		"commands.go":  strings.NewReplacer(`var commandsJSON = ""`, "var commandsJSON = "+strconv.Quote(values.commands)),
+		"audit.go":     strings.NewReplacer(`var auditJSON = ""`, "var auditJSON = "+strconv.Quote(values.audit)),
 	}
 
-	err = ioutil.WriteFile(filepath.Join(workDir, "version.go"), version_go, os.FileMode(0644))
-	if err != nil {
-		return err
+	for _, name := range AssetNames() {
+		data, err := Asset(name)
+		if err != nil {
+			return err
+		}
+		if r, ok := replacers[name]; ok {
+			data = []byte(r.Replace(string(data)))
+		}
+
+		err = ioutil.WriteFile(filepath.Join(workDir, name), data, os.FileMode(0644))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func writeBindata(workDir, input string) error {
-	if _, err := os.Stat(filepath.Join(input, "bin")); err != nil {
-		return fmt.Errorf("bin directory does not exist under %s", input)
-	}
-
+// translateBindata runs go-bindata on everything in dir.
+func translateBindata(output, dir string) error {
 	cfg := bindata.NewConfig()
 	cfg.Input = []bindata.InputConfig{
-		{Path: input, Recursive: true},
+		{Path: dir, Recursive: true},
 	}
-	cfg.Prefix = input
-	cfg.Output = filepath.Join(workDir, "bindata.go")
+	cfg.Prefix = dir
+	cfg.Output = output
 	return bindata.Translate(cfg)
 }
 
+// sushiboxModule and sushiboxSum make the runtime a module of its own.
+// Otherwise go builds it as the package _/<work directory>, and the name
+// of the temporary work directory ends up in the binary.
+const sushiboxModule = `module sushibox
+
+go 1.24
+
+require github.com/mitchellh/go-homedir v1.1.0
+`
+
+const sushiboxSum = `github.com/mitchellh/go-homedir v1.1.0 h1:lukF9ziXFxDFPkA1vsr5zpc1XuPDn/wFntq5mG+4E0Y=
+github.com/mitchellh/go-homedir v1.1.0/go.mod h1:SfyaCUpYCn1Vlf4IUYiD9fPX4A5wJrkLzIz1N1q0pr0=
+`
+
 func buildSushibox(workDir, output string) error {
-	cmd := exec.Command("go", "build", "-o", output)
+	if err := ioutil.WriteFile(filepath.Join(workDir, "go.mod"), []byte(sushiboxModule), os.FileMode(0644)); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(workDir, "go.sum"), []byte(sushiboxSum), os.FileMode(0644)); err != nil {
+		return err
+	}
+	args := []string{"build", "-o", output}
+	if *reproducible {
+		args = append(args, "-trimpath", "-buildvcs=false", "-ldflags=-buildid=")
+	}
+	if *verbose {
+		args = append(args, "-v")
+	}
+	cmd := exec.Command("go", args...)
 	cmd.Dir = workDir
-	return cmd.Run()
+	if *reproducible {
+		// GOPATH mode would ignore go.mod.
+		cmd.Env = append(os.Environ(), "GO111MODULE=on")
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if *verbose {
+		// The compiler output is already on the terminal, so it is left out of the error.
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+	}
+	if err := cmd.Run(); err != nil {
+		if *verbose {
+			return fmt.Errorf("go %s: %v", strings.Join(args, " "), err)
+		}
+		return fmt.Errorf("go %s: %v\n%s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
 }
 
 func errorExit(format string, a ...interface{}) int {
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+type manifestEntry struct {
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Mode    os.FileMode `json:"mode"`
+	Target  string      `json:"target,omitempty"`
+	Size    int64       `json:"size,omitempty"`
+	ModTime int64       `json:"mtime,omitempty"`
+	Hash    string      `json:"hash,omitempty"`
+
+	Interpreter     string   `json:"interpreter,omitempty"`
+	InterpreterArgs []string `json:"args,omitempty"`
+
+	Description string `json:"description,omitempty"`
+	Group       string `json:"group,omitempty"`
+
+	// source is set for files staged from outside of the input.
+	source string
+}
+
+const (
+	entryFile     = "file"
+	entryDir      = "dir"
+	entrySymlink  = "symlink"
+	entryHardlink = "hardlink"
+)
+
+// collectManifest walks the input tree without following symlinks and
+// records every directory, regular file, symlink and hardlink in it.
+func collectManifest(input string) (entries []manifestEntry, err error) {
+	type inode struct {
+		dev uint64
+		ino uint64
+	}
+	seen := make(map[inode]string)
+
+	f := func(src string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(input, src)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+		if err := validAssetName(name); err != nil {
+			return err
+		}
+		mode := info.Mode()
+
+		switch {
+		case mode.IsDir():
+			entries = append(entries, manifestEntry{Name: name, Type: entryDir, Mode: mode})
+		case mode&os.ModeSymlink != 0:
+			target, err := os.Readlink(src)
+			if err != nil {
+				return err
+			}
+			target = filepath.ToSlash(target)
+			entries = append(entries, manifestEntry{Name: name, Type: entrySymlink, Mode: mode, Target: target})
+		case mode.IsRegular():
+			if st, ok := info.Sys().(*syscall.Stat_t); ok && st.Nlink > 1 {
+				key := inode{uint64(st.Dev), uint64(st.Ino)}
+				if first, ok := seen[key]; ok {
+					entries = append(entries, manifestEntry{Name: name, Type: entryHardlink, Mode: mode, Target: first})
+					return nil
+				}
+				seen[key] = name
+			}
+			hash, err := fileHash(src)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, manifestEntry{Name: name, Type: entryFile, Mode: mode, Size: info.Size(), ModTime: info.ModTime().Unix(), Hash: hash})
+		default:
+			return fmt.Errorf("unsupported file type %s: %s", name, mode)
+		}
+		return nil
+	}
+	if err = filepath.Walk(input, f); err != nil {
+		return
+	}
+
+	// Links are checked once all of them are known, as one may lead
+	// through another which sorts after it.
+	links := symlinkTargets(entries)
+	for _, e := range entries {
+		if e.Type == entrySymlink && !linkWithinRoot(links, e.Name, e.Target) {
+			return nil, fmt.Errorf("symlink %s -> %s escapes %s", e.Name, e.Target, input)
+		}
+	}
+	return
+}
+
+func fileHash(src string) (string, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// validAssetName rejects names that the runtime would refuse to restore,
+// e.g. names with backslashes which go-bindata turns into separators.
+func validAssetName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("invalid asset name: empty")
+	case strings.Contains(name, "\\"):
+		return fmt.Errorf("invalid asset name %q: contains backslash", name)
+	case path.IsAbs(name):
+		return fmt.Errorf("invalid asset name %q: absolute path", name)
+	case path.Clean(name) != name:
+		return fmt.Errorf("invalid asset name %q: not canonical", name)
+	case name == ".." || strings.HasPrefix(name, "../"):
+		return fmt.Errorf("invalid asset name %q: escapes the root", name)
+	}
+	return nil
+}
+
+// maxLinkHops bounds how many symlinks a target may go through, as the
+// kernel does before giving up with ELOOP.
+const maxLinkHops = 40
+
+// symlinkTargets maps the name of every symlink entry to its target.
+func symlinkTargets(entries []manifestEntry) map[string]string {
+	links := make(map[string]string)
+	for _, e := range entries {
+		if e.Type == entrySymlink {
+			links[e.Name] = e.Target
+		}
+	}
+	return links
+}
+
+// linkWithinRoot reports whether a relative symlink target, resolved
+// from the directory holding name, stays inside the bundle root. Like
+// the kernel, it follows the symlinks in links met on the way, so that a
+// ".." after one of them is taken from where that link leads.
+func linkWithinRoot(links map[string]string, name, target string) bool {
+	hops := 0
+	var walk func(dir []string, target string) ([]string, bool)
+	walk = func(dir []string, target string) ([]string, bool) {
+		if target == "" || path.IsAbs(target) {
+			return nil, false
+		}
+		cur := append([]string{}, dir...)
+		for _, c := range strings.Split(target, "/") {
+			switch c {
+			case "", ".":
+				continue
+			case "..":
+				if len(cur) == 0 {
+					return nil, false
+				}
+				cur = cur[:len(cur)-1]
+				continue
+			}
+			cur = append(cur, c)
+			next, ok := links[strings.Join(cur, "/")]
+			if !ok {
+				continue
+			}
+			if hops++; hops > maxLinkHops {
+				return nil, false
+			}
+			if cur, ok = walk(cur[:len(cur)-1], next); !ok {
+				return nil, false
+			}
+		}
+		return cur, true
+	}
+
+	var dir []string
+	if d := path.Dir(name); d != "." {
+		dir = strings.Split(d, "/")
+	}
+	_, ok := walk(dir, target)
+	return ok
+}
+
+// payloadHash identifies the payload by its manifest, which holds the
+// name, type, mode, mtime and content hash of everything in it.
+func payloadHash(manifest string) string {
+	sum := sha256.Sum256([]byte(manifest))
+	return hex.EncodeToString(sum[:])
+}
+
+// sortEntries orders entries by name, which keeps every directory ahead
+// of its contents.
+func sortEntries(entries []manifestEntry) []manifestEntry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+func marshalManifest(entries []manifestEntry) (string, error) {
+	if entries == nil {
+		entries = []manifestEntry{}
+	}
+	buf, err := json.Marshal(entries)
+	return string(buf), err
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func makeInput(t *testing.T) string {
+	input, err := ioutil.TempDir("", "sushimaster_test_")
+	assert.Nil(t, err)
+	assert.Nil(t, os.MkdirAll(filepath.Join(input, "bin"), os.FileMode(0755)))
+	assert.Nil(t, os.MkdirAll(filepath.Join(input, "var", "log"), os.FileMode(0750)))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "bin", "python3.11"), []byte("python"), os.FileMode(0755)))
+	assert.Nil(t, os.Symlink("python3.11", filepath.Join(input, "bin", "python")))
+	assert.Nil(t, os.Link(filepath.Join(input, "bin", "python3.11"), filepath.Join(input, "bin", "python3")))
+	return input
+}
+
+func TestCollectManifest(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+
+	types := make(map[string]manifestEntry)
+	for _, e := range entries {
+		types[e.Name] = e
+	}
+	assert.Equal(t, 6, len(entries))
+	assert.Equal(t, entryDir, types["bin"].Type)
+	assert.Equal(t, entryDir, types["var/log"].Type)
+	assert.Equal(t, entryFile, types["bin/python3"].Type)
+	assert.Equal(t, entrySymlink, types["bin/python"].Type)
+	assert.Equal(t, "python3.11", types["bin/python"].Target)
+	assert.Equal(t, entryHardlink, types["bin/python3.11"].Type)
+	assert.Equal(t, "bin/python3", types["bin/python3.11"].Target)
+}
+
+func TestCollectManifestEscapingSymlink(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	assert.Nil(t, os.Symlink("../../etc/passwd", filepath.Join(input, "bin", "passwd")))
+	_, err := collectManifest(input)
+	assert.NotNil(t, err)
+}
+
+func TestCollectManifestInvalidName(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "bin", "..\\..\\evil"), []byte("evil"), os.FileMode(0644)))
+	_, err := collectManifest(input)
+	assert.NotNil(t, err)
+}
+
+func TestValidAssetName(t *testing.T) {
+	assert.Nil(t, validAssetName("bin/foo"))
+	for _, name := range []string{"", "../foo", "..", "/bin/foo", "bin//foo", "./bin", "bin/../../foo", "bin\\foo"} {
+		assert.NotNil(t, validAssetName(name), name)
+	}
+}
+
+func TestLinkWithinRoot(t *testing.T) {
+	assert.True(t, linkWithinRoot(nil, "bin/python", "python3.11"))
+	assert.True(t, linkWithinRoot(nil, "bin/python", "../lib/python"))
+	assert.False(t, linkWithinRoot(nil, "bin/python", "../../python"))
+	assert.False(t, linkWithinRoot(nil, "python", ".."))
+	assert.False(t, linkWithinRoot(nil, "bin/python", "/usr/bin/python"))
+
+	links := map[string]string{"d/link": "..", "loop": "loop"}
+	assert.True(t, linkWithinRoot(links, "d/link", ".."))
+	assert.True(t, linkWithinRoot(links, "e", "d/link"))
+	assert.False(t, linkWithinRoot(links, "e", "d/link/.."))
+	assert.False(t, linkWithinRoot(links, "e", "loop/x"))
+}
+
+func TestCollectManifestEscapingSymlinkChain(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	assert.Nil(t, os.Mkdir(filepath.Join(input, "d"), os.FileMode(0755)))
+	assert.Nil(t, os.Symlink("..", filepath.Join(input, "d", "link")))
+	assert.Nil(t, os.Symlink("d/link/..", filepath.Join(input, "e")))
+	_, err := collectManifest(input)
+	assert.NotNil(t, err)
+}
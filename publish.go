@@ -0,0 +1,258 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The release index read by sushibox -self-update, signed with the same
+// key as the bundles so that they trust it.
+const (
+	releaseIndexFile = "index.json"
+	releaseSigFile   = "index.json.sig"
+)
+
+type releaseIndex struct {
+	Name     string    `json:"name,omitempty"`
+	Releases []release `json:"releases"`
+}
+
+type release struct {
+	Version   string `json:"version"`
+	Semver    string `json:"semver,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	File      string `json:"file"`
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+}
+
+// readBundleInfo asks a built sushibox for its metadata. A bundle only
+// takes options when it runs as sushibox, and is otherwise run as the
+// bundled command of its name, so argv0 is set whatever the file is
+// called.
+func readBundleInfo(binary string) (*buildInfo, error) {
+	abs, err := filepath.Abs(binary)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &exec.Cmd{Path: abs, Args: []string{"sushibox", "-version", "-json"}}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s -version -json: %v", binary, err)
+	}
+	info := &buildInfo{}
+	if err := json.Unmarshal(out, info); err != nil {
+		return nil, fmt.Errorf("%s -version -json: %v", binary, err)
+	}
+	if info.Build == "" {
+		return nil, fmt.Errorf("%s does not report its build version", binary)
+	}
+	return info, nil
+}
+
+func loadReleaseIndex(dir string) (*releaseIndex, error) {
+	idx := &releaseIndex{}
+	buf, err := ioutil.ReadFile(filepath.Join(dir, releaseIndexFile))
+	if os.IsNotExist(err) {
+		return idx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// compareSemver compares two semantic versions, ignoring build metadata.
+func compareSemver(a, b string) int {
+	split := func(v string) ([3]int, string) {
+		v = strings.TrimPrefix(v, "v")
+		if i := strings.Index(v, "+"); i >= 0 {
+			v = v[:i]
+		}
+		pre := ""
+		if i := strings.Index(v, "-"); i >= 0 {
+			v, pre = v[:i], v[i+1:]
+		}
+		var n [3]int
+		for i, p := range strings.SplitN(v, ".", 3) {
+			n[i], _ = strconv.Atoi(p)
+		}
+		return n, pre
+	}
+	an, apre := split(a)
+	bn, bpre := split(b)
+	for i := range an {
+		if an[i] != bn[i] {
+			if an[i] < bn[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case apre == bpre:
+		return 0
+	case apre == "":
+		return 1
+	case bpre == "":
+		return -1
+	}
+	return strings.Compare(apre, bpre)
+}
+
+// compareRelease orders releases the way sushibox -self-update does: by
+// semantic version when both have one, then by build time, then by build
+// version when both are timestamps. The payload hashes reproducible builds
+// are versioned by do not sort.
+func compareRelease(a, b release) (int, error) {
+	if a.Semver != "" && b.Semver != "" {
+		if c := compareSemver(a.Semver, b.Semver); c != 0 {
+			return c, nil
+		}
+	}
+	if a.Version == b.Version {
+		return 0, nil
+	}
+	at, aerr := time.Parse(time.RFC3339, a.BuildTime)
+	bt, berr := time.Parse(time.RFC3339, b.BuildTime)
+	if aerr == nil && berr == nil && !at.Equal(bt) {
+		if at.Before(bt) {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	_, aerr = time.Parse(timestampVersion, a.Version)
+	_, berr = time.Parse(timestampVersion, b.Version)
+	if aerr != nil || berr != nil {
+		return 0, fmt.Errorf("cannot tell whether %s or %s is newer, build them with -semver", a.Version, b.Version)
+	}
+	return strings.Compare(a.Version, b.Version), nil
+}
+
+// publishRelease copies binary into dir as <name>-<build> and adds it to
+// the signed index there, replacing an earlier release of the same build.
+func publishRelease(dir, binary string, priv ed25519.PrivateKey) (*release, error) {
+	info, err := readBundleInfo(binary)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := loadReleaseIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+	if idx.Name != "" && info.Name != "" && idx.Name != info.Name {
+		return nil, fmt.Errorf("%s has releases of %s, not %s", dir, idx.Name, info.Name)
+	}
+	if idx.Name == "" {
+		idx.Name = info.Name
+	}
+
+	data, err := ioutil.ReadFile(binary)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	r := release{
+		Version:   info.Build,
+		Semver:    info.Version,
+		BuildTime: info.BuildTime,
+		File:      info.Name + "-" + info.Build,
+		Size:      int64(len(data)),
+		SHA256:    hex.EncodeToString(sum[:]),
+	}
+	if err := validAssetName(r.File); err != nil {
+		return nil, err
+	}
+	releases := []release{r}
+	for _, old := range idx.Releases {
+		if old.Version != r.Version {
+			releases = append(releases, old)
+		}
+	}
+	// Releases are listed oldest first, in the order sushibox -self-update
+	// picks the newest one by.
+	var orderErr error
+	sort.SliceStable(releases, func(i, j int) bool {
+		c, err := compareRelease(releases[i], releases[j])
+		if err != nil && orderErr == nil {
+			orderErr = err
+		}
+		return c < 0
+	})
+	if orderErr != nil {
+		return nil, orderErr
+	}
+	idx.Releases = releases
+
+	if err := os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, r.File), data, os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+
+	buf, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, '\n')
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, buf))
+	// The index and its signature are replaced one after the other, so a
+	// reader may get one of each; sushibox -self-update fetches both again
+	// when they do not match.
+	if err := ioutil.WriteFile(filepath.Join(dir, releaseSigFile+".new"), []byte(sig+"\n"), os.FileMode(0644)); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, releaseIndexFile+".new"), buf, os.FileMode(0644)); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(filepath.Join(dir, releaseSigFile+".new"), filepath.Join(dir, releaseSigFile)); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(filepath.Join(dir, releaseIndexFile+".new"), filepath.Join(dir, releaseIndexFile)); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// publishMain implements `sushimaster publish -sign-key <key> <dir> <sushibox>`.
+func publishMain(args []string) int {
+	flags := flag.NewFlagSet("publish", flag.ExitOnError)
+	key := flags.String("sign-key", "", "ed25519 private key (PKCS#8 PEM) the bundle was signed with")
+	flags.Usage = func() {
+		fmt.Printf("Usage: %s publish -sign-key <key> <release directory> <sushibox>\n\n", filepath.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 2 || *key == "" {
+		flags.Usage()
+		return 1
+	}
+
+	priv, err := readPrivateKey(*key)
+	if err != nil {
+		return errorExit("readPrivateKey failed by %+v", err)
+	}
+	r, err := publishRelease(flags.Arg(0), flags.Arg(1), priv)
+	if err != nil {
+		return errorExit("publishRelease failed by %+v", err)
+	}
+	fmt.Printf("published %s as %s\n", r.Version, filepath.Join(flags.Arg(0), r.File))
+	return 0
+}
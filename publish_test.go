@@ -0,0 +1,152 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeBundleSource is a bundle which, like sushibox, only takes options
+// when run as sushibox, and prints the info kept next to it.
+const fakeBundleSource = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if filepath.Base(os.Args[0]) != "sushibox" || len(os.Args) != 3 || os.Args[1] != "-version" || os.Args[2] != "-json" {
+		fmt.Fprintf(os.Stderr, "no command %s\n", os.Args[0])
+		os.Exit(1)
+	}
+	exe, _ := os.Executable()
+	buf, err := ioutil.ReadFile(exe + ".json")
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Stdout.Write(buf)
+}
+`
+
+var fakeBundleBinary []byte
+
+func fakeBundle(t *testing.T, dir, name, semver, build string) string {
+	return fakeBundleInfo(t, dir, buildInfo{Name: name, Version: semver, Build: build})
+}
+
+// fakeBundleInfo writes a bundle to dir which reports info. It is named
+// after the build rather than sushibox, which it has to be run as.
+func fakeBundleInfo(t *testing.T, dir string, info buildInfo) string {
+	if fakeBundleBinary == nil {
+		src, err := ioutil.TempDir("", "sushimaster_fake_")
+		assert.Nil(t, err)
+		defer os.RemoveAll(src)
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(src, "go.mod"), []byte("module fake\n"), os.FileMode(0644)))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(src, "main.go"), []byte(fakeBundleSource), os.FileMode(0644)))
+		cmd := exec.Command("go", "build", "-o", "fake")
+		cmd.Dir = src
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Skipf("cannot build a fake bundle: %v\n%s", err, out)
+		}
+		fakeBundleBinary, err = ioutil.ReadFile(filepath.Join(src, "fake"))
+		assert.Nil(t, err)
+	}
+	buf, err := json.Marshal(info)
+	assert.Nil(t, err)
+	p := filepath.Join(dir, info.Build)
+	assert.Nil(t, ioutil.WriteFile(p, fakeBundleBinary, os.FileMode(0755)))
+	assert.Nil(t, ioutil.WriteFile(p+".json", buf, os.FileMode(0644)))
+	return p
+}
+
+func TestPublishRelease(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sushimaster_publish_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	releases := filepath.Join(dir, "releases")
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	r, err := publishRelease(releases, fakeBundle(t, dir, "data", "1.1.0", "20261019-120000"), priv)
+	assert.Nil(t, err)
+	assert.Equal(t, "data-20261019-120000", r.File)
+	_, err = publishRelease(releases, fakeBundle(t, dir, "data", "1.0.0", "20261001-120000"), priv)
+	assert.Nil(t, err)
+
+	buf, err := ioutil.ReadFile(filepath.Join(releases, releaseIndexFile))
+	assert.Nil(t, err)
+	encoded, err := ioutil.ReadFile(filepath.Join(releases, releaseSigFile))
+	assert.Nil(t, err)
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	assert.Nil(t, err)
+	assert.True(t, ed25519.Verify(pub, buf, sig))
+
+	var idx releaseIndex
+	assert.Nil(t, json.Unmarshal(buf, &idx))
+	assert.Equal(t, "data", idx.Name)
+	assert.Equal(t, 2, len(idx.Releases))
+	assert.Equal(t, "20261001-120000", idx.Releases[0].Version)
+	assert.Equal(t, "1.1.0", idx.Releases[1].Semver)
+	info, err := os.Stat(filepath.Join(releases, r.File))
+	assert.Nil(t, err)
+	assert.Equal(t, r.Size, info.Size())
+
+	_, err = publishRelease(releases, fakeBundle(t, dir, "other", "", "20261020-120000"), priv)
+	assert.NotNil(t, err)
+}
+
+func TestPublishReproducible(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sushimaster_publish_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	releases := filepath.Join(dir, "releases")
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	_, err = publishRelease(releases, fakeBundleInfo(t, dir, buildInfo{Name: "data", Build: "fedcba9876543210", BuildTime: "2026-10-19T00:00:00Z"}), priv)
+	assert.Nil(t, err)
+	_, err = publishRelease(releases, fakeBundleInfo(t, dir, buildInfo{Name: "data", Build: "0123456789abcdef", BuildTime: "2026-10-01T00:00:00Z"}), priv)
+	assert.Nil(t, err)
+	idx, err := loadReleaseIndex(releases)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(idx.Releases))
+	assert.Equal(t, "0123456789abcdef", idx.Releases[0].Version)
+	assert.Equal(t, "2026-10-01T00:00:00Z", idx.Releases[0].BuildTime)
+
+	// Another build from the same time cannot be ordered.
+	_, err = publishRelease(releases, fakeBundleInfo(t, dir, buildInfo{Name: "data", Build: "00112233445566778", BuildTime: "2026-10-19T00:00:00Z"}), priv)
+	assert.NotNil(t, err)
+	idx, err = loadReleaseIndex(releases)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(idx.Releases))
+	_, err = os.Stat(filepath.Join(releases, "data-00112233445566778"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPublishRelativeName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sushimaster_publish_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	fakeBundleInfo(t, dir, buildInfo{Name: "data", Build: "v1"})
+
+	// A bare name is not looked up in PATH.
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	assert.Nil(t, os.Chdir(dir))
+	r, err := publishRelease("releases", "v1", priv)
+	assert.Nil(t, err)
+	assert.Equal(t, "data-v1", r.File)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+var reproducible = flag.Bool("reproducible", false, "build byte for byte identical output for identical input")
+
+const sourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+// sourceDateEpoch is the mtime given to every asset in reproducible mode.
+func sourceDateEpoch() (time.Time, error) {
+	s := os.Getenv(sourceDateEpochEnv)
+	if s == "" {
+		return time.Unix(0, 0), nil
+	}
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: %v", sourceDateEpochEnv, s, err)
+	}
+	return time.Unix(sec, 0), nil
+}
+
+// normalizeModTimes sets the mtime every file will be staged with to t.
+func normalizeModTimes(entries []manifestEntry, t time.Time) {
+	for i := range entries {
+		if entries[i].Type == entryFile {
+			entries[i].ModTime = t.Unix()
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeModTimes(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+
+	normalizeModTimes(entries, time.Unix(1418097391, 0))
+	for _, e := range entries {
+		if e.Type == entryFile {
+			assert.Equal(t, int64(1418097391), e.ModTime)
+		}
+	}
+}
+
+func TestSourceDateEpoch(t *testing.T) {
+	defer os.Unsetenv(sourceDateEpochEnv)
+
+	epoch, err := sourceDateEpoch()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Unix(0, 0), epoch)
+
+	os.Setenv(sourceDateEpochEnv, "1418097391")
+	epoch, err = sourceDateEpoch()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Unix(1418097391, 0), epoch)
+
+	os.Setenv(sourceDateEpochEnv, "yesterday")
+	_, err = sourceDateEpoch()
+	assert.NotNil(t, err)
+}
+
+func TestReproducibleBuild(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip(err)
+	}
+	dir, err := ioutil.TempDir("", "sushimaster_test_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	input := filepath.Join(dir, "input")
+	assert.Nil(t, os.MkdirAll(filepath.Join(input, "bin"), os.FileMode(0755)))
+	writeScript(t, input, "bin/foo", "#!/bin/sh\necho foo\n")
+
+	*reproducible = true
+	defer func() { *reproducible = false }()
+	os.Setenv(sourceDateEpochEnv, "1700000000")
+	defer os.Unsetenv(sourceDateEpochEnv)
+
+	// Each build gets its own work directory, as without -workdir.
+	var binaries [][]byte
+	for i := 0; i < 2; i++ {
+		workDir, err := ioutil.TempDir("", "sushimaster_")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+		output := filepath.Join(dir, "sushibox"+strconv.Itoa(i))
+		_, err = build(workDir, input, output)
+		if err != nil && strings.Contains(err.Error(), "undefined: AssetInfo") {
+			t.Skip("go-bindata is too old to build the runtime")
+		}
+		if !assert.Nil(t, err) {
+			return
+		}
+		buf, err := ioutil.ReadFile(output)
+		assert.Nil(t, err)
+		assert.False(t, bytes.Contains(buf, []byte(workDir)))
+		binaries = append(binaries, buf)
+	}
+	assert.True(t, bytes.Equal(binaries[0], binaries[1]))
+}
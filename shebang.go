@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var checkShebangs = flag.Bool("check-shebangs", false, "fail when a script in bin/ needs an interpreter that is neither bundled nor allowed")
+var allowInterpreters = flag.String("allow-interpreters", "/bin/sh", "comma separated interpreters scripts may use from the host")
+var bundledInterpreters = flag.Bool("bundled-interpreters", false, "run scripts in bin/ with the interpreter of the same name bundled in bin/")
+
+// maxShebang is how much of a file is read looking for a shebang line,
+// the same limit as recent Linux kernels.
+const maxShebang = 256
+
+type shebang struct {
+	name        string
+	interpreter string   // as written, e.g. /usr/bin/env
+	program     string   // what actually runs, e.g. python3
+	args        []string // arguments before the script path
+	bundled     string   // bundle path of the interpreter, if any
+}
+
+// readShebang parses the #! line of src, returning nil if there is none.
+func readShebang(name, src string) (*shebang, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReaderSize(io.LimitReader(f, maxShebang), maxShebang).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "#!") {
+		return nil, nil
+	}
+	fields := strings.Fields(line[2:])
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	sb := &shebang{name: name, interpreter: fields[0], program: fields[0]}
+	if path.Base(fields[0]) != "env" {
+		// The kernel passes everything after the interpreter as one argument.
+		if rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[2:]), fields[0])); rest != "" {
+			sb.args = []string{rest}
+		}
+		return sb, nil
+	}
+	args := fields[1:]
+	if len(args) > 0 && args[0] == "-S" {
+		args = args[1:]
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return nil, fmt.Errorf("script %s: unsupported env shebang %q", name, strings.TrimSpace(line))
+	}
+	sb.program, sb.args = args[0], args[1:]
+	return sb, nil
+}
+
+// String is the shebang without the leading #!.
+func (sb *shebang) String() string {
+	if sb.interpreter != sb.program {
+		return strings.Join(append([]string{sb.interpreter, sb.program}, sb.args...), " ")
+	}
+	return strings.Join(append([]string{sb.interpreter}, sb.args...), " ")
+}
+
+// allowed reports whether the host interpreter is in -allow-interpreters,
+// either by path or, for env shebangs, by program name.
+func (sb *shebang) allowed() bool {
+	for _, a := range strings.Split(*allowInterpreters, ",") {
+		a = strings.TrimSpace(a)
+		if a != "" && (a == sb.program || a == sb.interpreter) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectShebangs reads the shebang of every regular file under bin/ and
+// looks for an interpreter of the same name in bin/.
+func collectShebangs(input string, entries []manifestEntry) ([]*shebang, error) {
+	names := make(map[string]bool)
+	for _, e := range entries {
+		names[e.Name] = e.Type != entryDir
+	}
+
+	var shebangs []*shebang
+	for _, e := range entries {
+		if e.Type != entryFile || !strings.HasPrefix(e.Name, "bin/") {
+			continue
+		}
+		src := filepath.Join(input, filepath.FromSlash(e.Name))
+		if e.source != "" {
+			src = e.source
+		}
+		sb, err := readShebang(e.Name, src)
+		if err != nil {
+			return nil, err
+		}
+		if sb == nil {
+			continue
+		}
+		if candidate := path.Join("bin", path.Base(sb.program)); names[candidate] && candidate != e.Name {
+			sb.bundled = candidate
+		}
+		shebangs = append(shebangs, sb)
+	}
+	return shebangs, nil
+}
+
+// applyInterpreters records the bundled interpreter of each script in its
+// manifest entry, so that the runtime execs it instead of the host one.
+func applyInterpreters(entries []manifestEntry, shebangs []*shebang) {
+	byName := make(map[string]*shebang)
+	for _, sb := range shebangs {
+		if sb.bundled != "" {
+			byName[sb.name] = sb
+		}
+	}
+	for i := range entries {
+		if sb, ok := byName[entries[i].Name]; ok {
+			entries[i].Interpreter = sb.bundled
+			entries[i].InterpreterArgs = sb.args
+		}
+	}
+}
+
+func writeShebangs(w io.Writer, shebangs []*shebang) {
+	sorted := append([]*shebang{}, shebangs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+	for _, sb := range sorted {
+		switch {
+		case sb.bundled != "":
+			fmt.Fprintf(w, "bundled    %s: %s -> %s\n", sb.name, sb, sb.bundled)
+		case sb.allowed():
+			fmt.Fprintf(w, "host       %s: %s\n", sb.name, sb)
+		default:
+			fmt.Fprintf(w, "disallowed %s: %s\n", sb.name, sb)
+		}
+	}
+}
+
+// checkInterpreters fails on scripts whose interpreter is neither bundled
+// nor allowed.
+func checkInterpreters(shebangs []*shebang) error {
+	var bad []string
+	for _, sb := range shebangs {
+		if sb.bundled == "" && !sb.allowed() {
+			bad = append(bad, sb.name)
+		}
+	}
+	if len(bad) > 0 {
+		sort.Strings(bad)
+		return fmt.Errorf("interpreters neither bundled nor allowed: %s", strings.Join(bad, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeScript(t *testing.T, input, name, content string) {
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, filepath.FromSlash(name)), []byte(content), os.FileMode(0755)))
+}
+
+func TestReadShebang(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+
+	tests := []struct {
+		content     string
+		interpreter string
+		program     string
+		args        []string
+	}{
+		{"#!/bin/sh\necho\n", "/bin/sh", "/bin/sh", nil},
+		{"#! /usr/bin/python3 -u -O\n", "/usr/bin/python3", "/usr/bin/python3", []string{"-u -O"}},
+		{"#!/usr/bin/env python3\n", "/usr/bin/env", "python3", []string{}},
+		{"#!/usr/bin/env -S perl -w\n", "/usr/bin/env", "perl", []string{"-w"}},
+	}
+	for _, tt := range tests {
+		writeScript(t, input, "bin/script", tt.content)
+		sb, err := readShebang("bin/script", filepath.Join(input, "bin", "script"))
+		assert.Nil(t, err)
+		if assert.NotNil(t, sb, tt.content) {
+			assert.Equal(t, tt.interpreter, sb.interpreter)
+			assert.Equal(t, tt.program, sb.program)
+			assert.Equal(t, tt.args, sb.args)
+		}
+	}
+
+	sb, err := readShebang("bin/python3", filepath.Join(input, "bin", "python3"))
+	assert.Nil(t, err)
+	assert.Nil(t, sb)
+
+	writeScript(t, input, "bin/script", "#!/usr/bin/env -i python3\n")
+	_, err = readShebang("bin/script", filepath.Join(input, "bin", "script"))
+	assert.NotNil(t, err)
+}
+
+func TestCollectShebangs(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	writeScript(t, input, "bin/tool", "#!/usr/bin/env python3\nprint('tool')\n")
+	writeScript(t, input, "bin/run", "#!/bin/sh\nexec tool\n")
+	writeScript(t, input, "bin/gem", "#!/usr/bin/ruby\n")
+
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	shebangs, err := collectShebangs(input, entries)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(shebangs))
+
+	var buf bytes.Buffer
+	writeShebangs(&buf, shebangs)
+	assert.Equal(t, "disallowed bin/gem: /usr/bin/ruby\n"+
+		"host       bin/run: /bin/sh\n"+
+		"bundled    bin/tool: /usr/bin/env python3 -> bin/python3\n", buf.String())
+
+	err = checkInterpreters(shebangs)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "bin/gem")
+
+	*allowInterpreters = "/bin/sh, ruby, /usr/bin/ruby"
+	defer func() { *allowInterpreters = "/bin/sh" }()
+	assert.Nil(t, checkInterpreters(shebangs))
+
+	applyInterpreters(entries, shebangs)
+	for _, e := range entries {
+		switch e.Name {
+		case "bin/tool":
+			assert.Equal(t, "bin/python3", e.Interpreter)
+		default:
+			assert.Equal(t, "", e.Interpreter, e.Name)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+)
+
+var signKey = flag.String("sign-key", "", "sign the manifest with the ed25519 private key (PKCS#8 PEM) at this path")
+
+func readPrivateKey(path string) (ed25519.PrivateKey, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key %s: %v", path, err)
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key %s is not ed25519", path)
+	}
+	return priv, nil
+}
+
+// signManifest returns the base64 signature of manifest and the public
+// key to embed, or empty strings when -sign-key is not given.
+func signManifest(manifest string) (signature, publicKey string, err error) {
+	if *signKey == "" {
+		return
+	}
+	priv, err := readPrivateKey(*signKey)
+	if err != nil {
+		return
+	}
+	sig := ed25519.Sign(priv, []byte(manifest))
+	pub := priv.Public().(ed25519.PublicKey)
+	signature = base64.StdEncoding.EncodeToString(sig)
+	publicKey = base64.StdEncoding.EncodeToString(pub)
+	return
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestSignManifest(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.Nil(t, err)
+
+	f, err := ioutil.TempFile("", "sushimaster_key_")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+	assert.Nil(t, pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	f.Close()
+
+	*signKey = f.Name()
+	defer func() { *signKey = "" }()
+
+	signature, publicKey, err := signManifest(`[]`)
+	assert.Nil(t, err)
+	sig, _ := base64.StdEncoding.DecodeString(signature)
+	pub, _ := base64.StdEncoding.DecodeString(publicKey)
+	assert.True(t, ed25519.Verify(ed25519.PublicKey(pub), []byte(`[]`), sig))
+}
+
+func TestSignManifestWithoutKey(t *testing.T) {
+	signature, publicKey, err := signManifest(`[]`)
+	assert.Nil(t, err)
+	assert.Equal(t, "", signature)
+	assert.Equal(t, "", publicKey)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// stageInput copies the directories and regular files of the input into
+// dir with the modes and mtimes of their entries, and passes each file
+// through transform. Entries may be a subset, their directories are
+// created as needed.
+// go-bindata then reads from dir instead of the input.
+func stageInput(dir, input string, entries []manifestEntry, transform func(name string, data []byte) ([]byte, error)) error {
+	err := os.MkdirAll(dir, os.FileMode(0700))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		src := filepath.Join(input, filepath.FromSlash(e.Name))
+		if e.source != "" {
+			src = e.source
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(e.Name))
+		switch e.Type {
+		case entryDir:
+			if err := os.MkdirAll(dst, os.FileMode(0700)); err != nil {
+				return err
+			}
+		case entryFile:
+			if err := os.MkdirAll(filepath.Dir(dst), os.FileMode(0700)); err != nil {
+				return err
+			}
+			data, err := ioutil.ReadFile(src)
+			if err != nil {
+				return err
+			}
+			if transform != nil {
+				data, err = transform(e.Name, data)
+				if err != nil {
+					return err
+				}
+			}
+			if err := ioutil.WriteFile(dst, data, e.Mode.Perm()); err != nil {
+				return err
+			}
+			if err := os.Chmod(dst, e.Mode.Perm()); err != nil {
+				return err
+			}
+			mtime := time.Unix(e.ModTime, 0)
+			if err := os.Chtimes(dst, mtime, mtime); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// auditJSON is replaced by sushimaster with the audit settings of -config.
+// An audit log is kept when it is set or SUSHIBOX_AUDIT=1.
+var auditJSON = ""
+
+const auditEnv = "SUSHIBOX_AUDIT"
+
+const (
+	auditFile           = "audit.log"
+	auditRotations      = 3
+	defaultAuditMaxSize = 10 << 20
+)
+
+type auditConfig struct {
+	Redact  []string `json:"redact,omitempty"`
+	MaxSize int64    `json:"max_size,omitempty"`
+}
+
+type auditRecord struct {
+	Time     string   `json:"time"`
+	User     string   `json:"user"`
+	Cwd      string   `json:"cwd"`
+	Bundle   string   `json:"bundle,omitempty"`
+	Version  string   `json:"version"`
+	Command  string   `json:"command"`
+	Argv     []string `json:"argv"`
+	Duration float64  `json:"duration"`
+	ExitCode int      `json:"exit_code"`
+}
+
+func auditEnabled() bool {
+	return auditJSON != "" || os.Getenv(auditEnv) == "1"
+}
+
+func loadAuditConfig() (auditConfig, error) {
+	cfg := auditConfig{MaxSize: defaultAuditMaxSize}
+	if auditJSON != "" {
+		if err := json.Unmarshal([]byte(auditJSON), &cfg); err != nil {
+			return cfg, err
+		}
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = defaultAuditMaxSize
+	}
+	return cfg, nil
+}
+
+func auditPath() string {
+	return filepath.Join(SushiBoxDir, auditFile)
+}
+
+// redactArgs replaces what each pattern matches in args. The first
+// submatch, if any, is kept, so `(--password=).*` leaves the flag name.
+func redactArgs(args []string, patterns []string) ([]string, error) {
+	out := append([]string{}, args...)
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out {
+			out[i] = re.ReplaceAllString(out[i], "${1}[REDACTED]")
+		}
+	}
+	return out, nil
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// writeAudit appends a record of a finished command to the audit log,
+// rotating it first when it has grown past the configured size.
+func writeAudit(cmd string, args []string, cwd string, start time.Time, err error) error {
+	cfg, cfgErr := loadAuditConfig()
+	if cfgErr != nil {
+		return cfgErr
+	}
+	argv, redactErr := redactArgs(args, cfg.Redact)
+	if redactErr != nil {
+		return redactErr
+	}
+	info, _ := loadBuildInfo()
+	record := auditRecord{
+		Time:     start.UTC().Format(time.RFC3339Nano),
+		User:     currentUser(),
+		Cwd:      cwd,
+		Bundle:   info.Name,
+		Version:  Version,
+		Command:  cmd,
+		Argv:     argv,
+		Duration: time.Since(start).Seconds(),
+		ExitCode: exitStatus(err),
+	}
+	line, jsonErr := json.Marshal(record)
+	if jsonErr != nil {
+		return jsonErr
+	}
+
+	if err := rotateAudit(cfg.MaxSize); err != nil {
+		return err
+	}
+	f, openErr := os.OpenFile(auditPath(), os.O_WRONLY|os.O_APPEND|os.O_CREATE, os.FileMode(0600))
+	if openErr != nil {
+		return openErr
+	}
+	defer f.Close()
+	_, writeErr := f.Write(append(line, '\n'))
+	return writeErr
+}
+
+// rotateAudit shifts audit.log to audit.log.1 and so on once it is larger
+// than maxSize, keeping auditRotations old files.
+func rotateAudit(maxSize int64) error {
+	info, err := os.Stat(auditPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Size() < maxSize {
+		return nil
+	}
+	for i := auditRotations - 1; i >= 1; i-- {
+		os.Rename(auditPath()+"."+strconv.Itoa(i), auditPath()+"."+strconv.Itoa(i+1))
+	}
+	return os.Rename(auditPath(), auditPath()+".1")
+}
+
+// readAudit returns the records of every audit file, oldest first, of the
+// given command or of all commands when cmd is "".
+func readAudit(cmd string) ([]auditRecord, error) {
+	var records []auditRecord
+	for i := auditRotations; i >= 0; i-- {
+		p := auditPath()
+		if i > 0 {
+			p += "." + strconv.Itoa(i)
+		}
+		f, err := os.Open(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 1<<20)
+		for scanner.Scan() {
+			var r auditRecord
+			if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+				continue
+			}
+			if cmd == "" || r.Command == cmd {
+				records = append(records, r)
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// printAudit implements -audit [command].
+func printAudit(w io.Writer, cmd string, asJSON bool) error {
+	records, err := readAudit(cmd)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if asJSON {
+			line, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s\n", line)
+			continue
+		}
+		fmt.Fprintf(w, "%s %s %s exit=%d %.3fs %s %s\n", r.Time, r.User, r.Version, r.ExitCode, r.Duration, r.Command, strings.Join(r.Argv, " "))
+	}
+	return nil
+}
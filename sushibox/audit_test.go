@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+func (suite *SushiboxTestSuite) TestRedactArgs() {
+	args, err := redactArgs([]string{"--password=secret", "token", "-v"}, []string{"(--password=).*", "^token$"})
+	suite.Nil(err)
+	suite.Equal([]string{"--password=[REDACTED]", "[REDACTED]", "-v"}, args)
+
+	_, err = redactArgs([]string{"x"}, []string{"("})
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdAudit() {
+	auditJSON = `{"redact":["(--token=).*"]}`
+	suite.Nil(restoreFiles())
+
+	_, _, err := execCmd("foo", []string{"--token=abc", "x"})
+	suite.Nil(err)
+	records, err := readAudit("")
+	suite.Nil(err)
+	suite.Equal(1, len(records))
+	r := records[0]
+	suite.Equal("foo", r.Command)
+	suite.Equal([]string{"--token=[REDACTED]", "x"}, r.Argv)
+	suite.Equal(0, r.ExitCode)
+	suite.Equal(Version, r.Version)
+	cwd, _ := os.Getwd()
+	suite.Equal(cwd, r.Cwd)
+
+	info, err := os.Stat(auditPath())
+	suite.Nil(err)
+	suite.Equal(os.FileMode(0600), info.Mode().Perm())
+}
+
+func (suite *SushiboxTestSuite) TestAuditRotation() {
+	auditJSON = `{"max_size":10}`
+	for i := 0; i < 6; i++ {
+		suite.Nil(writeAudit("foo", []string{}, "/", time.Now(), nil))
+	}
+	for _, suffix := range []string{"", ".1", ".2", ".3"} {
+		_, err := os.Stat(auditPath() + suffix)
+		suite.Nil(err, suffix)
+	}
+	_, err := os.Stat(auditPath() + ".4")
+	suite.True(os.IsNotExist(err))
+
+	records, err := readAudit("foo")
+	suite.Nil(err)
+	suite.Equal(4, len(records))
+}
+
+func (suite *SushiboxTestSuite) TestPrintAudit() {
+	os.Setenv(auditEnv, "1")
+	suite.Nil(writeAudit("foo", []string{"a"}, "/", time.Now(), nil))
+	suite.Nil(writeAudit("bar", []string{"b"}, "/", time.Now(), &childExit{status: 2 << 8}))
+
+	var buf bytes.Buffer
+	suite.Nil(printAudit(&buf, "bar", false))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	suite.Equal(1, len(lines))
+	suite.Contains(lines[0], " exit=2 ")
+	suite.True(strings.HasSuffix(lines[0], " bar b"))
+
+	buf.Reset()
+	suite.Nil(printAudit(&buf, "", true))
+	suite.Equal(2, strings.Count(buf.String(), "\n"))
+}
+
+func (suite *SushiboxTestSuite) TestParseArgsAudit() {
+	os.Args = []string{"sushibox", "-audit", "foo"}
+	cmd, _, err := parseArgs()
+	suite.Nil(err)
+	suite.True(*audit)
+	suite.Equal("foo", cmd)
+
+	os.Args = []string{"sushibox", "-audit"}
+	cmd, _, err = parseArgs()
+	suite.Nil(err)
+	suite.Equal("", cmd)
+}
+
+func (suite *SushiboxTestSuite) TestAuditLogNotWrittenByDefault() {
+	suite.Nil(restoreFiles())
+	_, _, err := execCmd("foo", []string{})
+	suite.Nil(err)
+	_, err = ioutil.ReadFile(auditPath())
+	suite.True(os.IsNotExist(err))
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// buildInfoJSON is replaced by sushimaster with the metadata of the build.
+var buildInfoJSON = ""
+
+type buildInfo struct {
+	Name        string `json:"name,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Build       string `json:"build"`
+	BuildTime   string `json:"build_time,omitempty"`
+	Builder     string `json:"builder,omitempty"`
+	Sushimaster string `json:"sushimaster,omitempty"`
+	GoVersion   string `json:"go_version"`
+	Payload     string `json:"payload,omitempty"`
+	Assets      int    `json:"assets"`
+	Revision    string `json:"revision,omitempty"`
+}
+
+func loadBuildInfo() (buildInfo, error) {
+	info := buildInfo{Build: Version, Payload: PayloadHash, Assets: len(AssetNames())}
+	if buildInfoJSON != "" {
+		if err := json.Unmarshal([]byte(buildInfoJSON), &info); err != nil {
+			return info, err
+		}
+	}
+	info.GoVersion = runtime.Version()
+	return info, nil
+}
+
+// printVersion writes the build metadata for -version, starting with the
+// build version on a line by itself.
+func printVersion(w io.Writer, asJSON bool) error {
+	info, err := loadBuildInfo()
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(info)
+	}
+
+	fmt.Fprintf(w, "%s\n", info.Build)
+	fields := []struct{ key, value string }{
+		{"name", info.Name},
+		{"version", info.Version},
+		{"built", info.BuildTime},
+		{"builder", info.Builder},
+		{"sushimaster", info.Sushimaster},
+		{"go", info.GoVersion},
+		{"payload", info.Payload},
+		{"assets", fmt.Sprint(info.Assets)},
+		{"revision", info.Revision},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Fprintf(w, "%-12s %s\n", f.key+":", f.value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"runtime"
+)
+
+func (suite *SushiboxTestSuite) TestPrintVersion() {
+	buildInfoJSON = `{"name":"data","version":"1.2.0","build":"20261019-120000","build_time":"2026-10-19T12:00:00Z","payload":"0123abcd","assets":2}`
+
+	var buf bytes.Buffer
+	suite.Nil(printVersion(&buf, false))
+	suite.Equal("20261019-120000\n"+
+		"name:        data\n"+
+		"version:     1.2.0\n"+
+		"built:       2026-10-19T12:00:00Z\n"+
+		"go:          "+runtime.Version()+"\n"+
+		"payload:     0123abcd\n"+
+		"assets:      2\n", buf.String())
+
+	buf.Reset()
+	suite.Nil(printVersion(&buf, true))
+	var info buildInfo
+	suite.Nil(json.Unmarshal(buf.Bytes(), &info))
+	suite.Equal("data", info.Name)
+	suite.Equal("1.2.0", info.Version)
+	suite.Equal(runtime.Version(), info.GoVersion)
+}
+
+func (suite *SushiboxTestSuite) TestPrintVersionDeveloping() {
+	var buf bytes.Buffer
+	suite.Nil(printVersion(&buf, false))
+	suite.Contains(buf.String(), Version+"\n")
+}
+
+func (suite *SushiboxTestSuite) TestParseArgsVersionJSON() {
+	os.Args = []string{"sushibox", "-version", "-json"}
+	_, _, err := parseArgs()
+	suite.Nil(err)
+	suite.True(*version)
+	suite.True(*jsonOutput)
+}
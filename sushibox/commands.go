@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// commandsJSON is replaced by sushimaster with the per-command settings
+// given by -config.
+var commandsJSON = ""
+
+// rootEnv is set for every command to the directory the payload is
+// extracted to. ${SUSHIBOX_ROOT} in settings expands to the same.
+const rootEnv = "SUSHIBOX_ROOT"
+
+type commandConfig struct {
+	Env   map[string]string `json:"env,omitempty"`
+	Unset []string          `json:"unset,omitempty"`
+	Dir   string            `json:"dir,omitempty"`
+	Mode  string            `json:"mode,omitempty"`
+}
+
+func commandSettings(cmd string) (commandConfig, error) {
+	var commands map[string]commandConfig
+	if commandsJSON != "" {
+		if err := json.Unmarshal([]byte(commandsJSON), &commands); err != nil {
+			return commandConfig{}, err
+		}
+	}
+	return commands[cmd], nil
+}
+
+func expandRoot(s string) string {
+	return strings.Replace(s, "${"+rootEnv+"}", BaseDir, -1)
+}
+
+// setEnv returns envv with key set to value, replacing any earlier value.
+func setEnv(envv []string, key, value string) []string {
+	out := unsetEnv(envv, key)
+	return append(out, key+"="+value)
+}
+
+func unsetEnv(envv []string, key string) []string {
+	prefix := key + "="
+	out := make([]string, 0, len(envv))
+	for _, kv := range envv {
+		if !strings.HasPrefix(kv, prefix) {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+// commandEnv applies the settings of cmd to envv: variables are removed,
+// then set, and finally SUSHIBOX_ROOT is set.
+func commandEnv(c commandConfig, envv []string) []string {
+	for _, key := range c.Unset {
+		envv = unsetEnv(envv, key)
+	}
+	keys := make([]string, 0, len(c.Env))
+	for key := range c.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		envv = setEnv(envv, key, expandRoot(c.Env[key]))
+	}
+	return setEnv(envv, rootEnv, BaseDir)
+}
+
+// commandDir returns the directory cmd runs in, relative ones being
+// relative to the extracted payload, or "" to keep the caller's.
+func commandDir(c commandConfig) string {
+	if c.Dir == "" {
+		return ""
+	}
+	dir := filepath.FromSlash(expandRoot(c.Dir))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(BaseDir, dir)
+	}
+	return dir
+}
+
+// applyCommandDir changes into the directory of cmd, which the exec'd
+// process inherits.
+func applyCommandDir(c commandConfig) error {
+	if dir := commandDir(c); dir != "" {
+		return os.Chdir(dir)
+	}
+	return nil
+}
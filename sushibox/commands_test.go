@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) TestCommandEnv() {
+	c := commandConfig{
+		Env:   map[string]string{"JAVA_HOME": "${SUSHIBOX_ROOT}/jdk", "LANG": "C"},
+		Unset: []string{"CLASSPATH"},
+	}
+	envv := commandEnv(c, []string{"PATH=/bin", "CLASSPATH=/tmp", "LANG=ja_JP.UTF-8"})
+	suite.Equal([]string{
+		"PATH=/bin",
+		"JAVA_HOME=" + filepath.Join(BaseDir, "jdk"),
+		"LANG=C",
+		"SUSHIBOX_ROOT=" + BaseDir,
+	}, envv)
+}
+
+func (suite *SushiboxTestSuite) TestCommandDir() {
+	suite.Equal("", commandDir(commandConfig{}))
+	suite.Equal(filepath.Join(BaseDir, "data"), commandDir(commandConfig{Dir: "data"}))
+	suite.Equal(filepath.Join(BaseDir, "data"), commandDir(commandConfig{Dir: "${SUSHIBOX_ROOT}/data"}))
+	suite.Equal("/tmp", commandDir(commandConfig{Dir: "/tmp"}))
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdSettings() {
+	suite.mockFixtures()
+	suite.mockFile("bin/env", "#!/bin/sh\necho \"$(pwd -P) $JAVA_HOME [$CLASSPATH]\"\n", os.FileMode(0755))
+	suite.mockFile("data/README", "data", os.FileMode(0644))
+	commandsJSON = `{"env":{"env":{"JAVA_HOME":"${SUSHIBOX_ROOT}/jdk"},"unset":["CLASSPATH"],"dir":"data"}}`
+	os.Setenv("CLASSPATH", "/tmp")
+	defer os.Unsetenv("CLASSPATH")
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+
+	suite.Nil(restoreFiles())
+	stdout, _, err := execCmd("env", []string{})
+	suite.Nil(err)
+	data, _ := filepath.EvalSymlinks(filepath.Join(BaseDir, "data"))
+	suite.Equal(data+" "+filepath.Join(BaseDir, "jdk")+" []\n", string(stdout))
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdMissingDir() {
+	commandsJSON = `{"foo":{"dir":"missing"}}`
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+
+	suite.Nil(restoreFiles())
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// A bundle may ship completions/<cmd>, an executable which is run with the
+// words typed after <cmd>, the last one being the word to complete, and
+// prints one candidate per line. It works the same for every shell.
+const completionsDir = "completions"
+
+// completableName matches the names which are safe to write unquoted into
+// a completion script.
+var completableName = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`)
+
+// bundleNames returns the names of all files, links and assets in the
+// bundle.
+func bundleNames() map[string]bool {
+	names := make(map[string]bool)
+	for _, name := range AssetNames() {
+		names[name] = true
+	}
+	for _, e := range manifest {
+		if e.Type != entryDir {
+			names[e.Name] = true
+		}
+	}
+	return names
+}
+
+// bundledCommands returns the commands under bin/ and which of them have
+// a completer.
+func bundledCommands() (commands []string, completers map[string]bool) {
+	names := bundleNames()
+	completers = make(map[string]bool)
+	for name := range names {
+		dir, base := path.Split(name)
+		if dir != "bin/" || !completableName.MatchString(base) {
+			continue
+		}
+		commands = append(commands, base)
+		if names[completionsDir+"/"+base] {
+			completers[base] = true
+		}
+	}
+	sort.Strings(commands)
+	return
+}
+
+type completionFlag struct {
+	name, usage string
+}
+
+func completionFlags() (flags []completionFlag) {
+	flag.VisitAll(func(f *flag.Flag) {
+		if f.Name != "complete" {
+			flags = append(flags, completionFlag{f.Name, f.Usage})
+		}
+	})
+	return
+}
+
+func writeCompletion(w io.Writer, shell string) error {
+	commands, completers := bundledCommands()
+	var delegated []string
+	for _, c := range commands {
+		if completers[c] {
+			delegated = append(delegated, c)
+		}
+	}
+	var flags []string
+	for _, f := range completionFlags() {
+		flags = append(flags, "-"+f.name)
+	}
+
+	switch shell {
+	case "bash":
+		fmt.Fprintf(w, `_sushibox() {
+    local cur=${COMP_WORDS[COMP_CWORD]}
+    if [ "$COMP_CWORD" -eq 1 ]; then
+        COMPREPLY=($(compgen -W "%s" -- "$cur"))
+        return
+    fi
+    case ${COMP_WORDS[1]} in
+    %s)
+        COMPREPLY=($("${COMP_WORDS[0]}" -complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+        ;;
+    -completion)
+        COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur"))
+        return
+        ;;
+    esac
+    [ ${#COMPREPLY[@]} -eq 0 ] && COMPREPLY=($(compgen -f -- "$cur"))
+}
+complete -F _sushibox sushibox
+`, strings.Join(append(commands, flags...), " "), caseLabels(delegated))
+	case "zsh":
+		fmt.Fprintf(w, `#compdef sushibox
+_sushibox() {
+    if (( CURRENT == 2 )); then
+        compadd -- %s
+        return
+    fi
+    case ${words[2]} in
+    %s)
+        local -a candidates
+        candidates=(${(f)"$(${words[1]} -complete ${words[2,CURRENT]} 2>/dev/null)"})
+        (( ${#candidates} )) && compadd -- $candidates && return
+        ;;
+    -completion)
+        compadd -- bash zsh fish
+        return
+        ;;
+    esac
+    _files
+}
+compdef _sushibox sushibox
+`, strings.Join(append(commands, flags...), " "), caseLabels(delegated))
+	case "fish":
+		fmt.Fprintf(w, "complete -c sushibox -n __fish_use_subcommand -f -a '%s'\n", strings.Join(commands, " "))
+		for _, f := range completionFlags() {
+			fmt.Fprintf(w, "complete -c sushibox -n __fish_use_subcommand -o %s -d '%s'\n", f.name, strings.Replace(f.usage, "'", `\'`, -1))
+		}
+		fmt.Fprintf(w, "complete -c sushibox -n '__fish_seen_argument -o completion' -f -a 'bash zsh fish'\n")
+		for _, c := range delegated {
+			fmt.Fprintf(w, "complete -c sushibox -n '__fish_seen_subcommand_from %s' -f -a '(sushibox -complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)'\n", c)
+		}
+	default:
+		return fmt.Errorf("unsupported shell %s, expected bash, zsh or fish", shell)
+	}
+	return nil
+}
+
+// caseLabels returns a shell case pattern matching the given words, or one
+// which never matches when there are none.
+func caseLabels(words []string) string {
+	if len(words) == 0 {
+		return "''"
+	}
+	return strings.Join(words, "|")
+}
+
+// completeCmd runs the completer of cmd with the words typed after it and
+// copies the candidates to w. Commands without a completer have none.
+func completeCmd(w io.Writer, cmd string, words []string) error {
+	if _, completers := bundledCommands(); !completers[cmd] {
+		return nil
+	}
+	p := filepath.Join(BaseDir, completionsDir, cmd)
+	if err := checkExecPath(p); err != nil {
+		return err
+	}
+	if err := verifyExecFile(p); err != nil {
+		return err
+	}
+	arg0, argv, err := interpreterArgv(p, words)
+	if err != nil {
+		return err
+	}
+	settings, err := commandSettings(cmd)
+	if err != nil {
+		return err
+	}
+	completer := &exec.Cmd{Path: arg0, Args: argv, Env: commandEnv(settings, manEnv(libraryEnv(os.Environ()))), Stdout: w, Stderr: os.Stderr}
+	return completer.Run()
+}
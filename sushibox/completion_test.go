@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+)
+
+func (suite *SushiboxTestSuite) completionFixtures() {
+	suite.mockFixtures()
+	suite.mockFile("bin/bar", "#!/bin/sh\n", os.FileMode(0755))
+	suite.mockFile(completionsDir+"/foo", "#!/bin/sh\necho \"$# $*\"\necho build\n", os.FileMode(0755))
+}
+
+func (suite *SushiboxTestSuite) TestBundledCommands() {
+	suite.completionFixtures()
+	suite.setManifest(manifestEntry{Name: "bin/baz", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "foo"})
+
+	commands, completers := bundledCommands()
+	suite.Equal([]string{"bar", "baz", "foo"}, commands)
+	suite.Equal(map[string]bool{"foo": true}, completers)
+}
+
+func (suite *SushiboxTestSuite) TestWriteCompletion() {
+	suite.completionFixtures()
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		var buf bytes.Buffer
+		suite.Nil(writeCompletion(&buf, shell))
+		suite.Contains(buf.String(), "bar foo")
+		suite.Contains(buf.String(), "versions")
+		if sh, err := exec.LookPath(shell); err == nil {
+			cmd := exec.Command(sh, "-n")
+			cmd.Stdin = &buf
+			suite.Nil(cmd.Run(), shell)
+		}
+	}
+	suite.NotNil(writeCompletion(ioutil.Discard, "csh"))
+}
+
+func (suite *SushiboxTestSuite) TestCompleteCmd() {
+	suite.completionFixtures()
+	suite.Nil(restoreFiles())
+
+	var buf bytes.Buffer
+	suite.Nil(completeCmd(&buf, "foo", []string{"a", "b"}))
+	suite.Equal("2 a b\nbuild\n", buf.String())
+
+	buf.Reset()
+	suite.Nil(completeCmd(&buf, "bar", []string{""}))
+	suite.Equal("", buf.String())
+	suite.Nil(completeCmd(&buf, "../bin/foo", []string{""}))
+	suite.Equal("", buf.String())
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// encryptionSalt is replaced by sushimaster when the bundle is built with
+// -encrypt. Asset contents are then sealed with AES-256-GCM.
+var encryptionSalt = ""
+
+const keyEnv = "SUSHIBOX_KEY"
+const keyFileEnv = "SUSHIBOX_KEY_FILE"
+
+// Must match sushimaster.
+const kdfIterations = 200000
+
+var assetCipher cipher.AEAD
+
+func encrypted() bool {
+	return encryptionSalt != ""
+}
+
+// readPassphrase returns the passphrase from $SUSHIBOX_KEY,
+// $SUSHIBOX_KEY_FILE or, as a last resort, the terminal.
+func readPassphrase() (string, error) {
+	if key := os.Getenv(keyEnv); key != "" {
+		return key, nil
+	}
+	if path := os.Getenv(keyFileEnv); path != "" {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		passphrase := strings.TrimRight(string(buf), "\r\n")
+		if passphrase == "" {
+			return "", fmt.Errorf("empty passphrase in %s", path)
+		}
+		return passphrase, nil
+	}
+	return promptPassphrase("Passphrase for sushibox: ")
+}
+
+func promptPassphrase(prompt string) (string, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("bundle is encrypted, set %s or %s: %v", keyEnv, keyFileEnv, err)
+	}
+	defer tty.Close()
+
+	stty := func(args ...string) {
+		cmd := exec.Command("stty", args...)
+		cmd.Stdin = tty
+		cmd.Run()
+	}
+	stty("-echo")
+	defer stty("echo")
+
+	fmt.Fprint(tty, prompt)
+	line, err := bufio.NewReader(tty).ReadString('\n')
+	fmt.Fprintln(tty)
+	if err != nil {
+		return "", err
+	}
+	passphrase := strings.TrimRight(line, "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("empty passphrase")
+	}
+	return passphrase, nil
+}
+
+func initCipher() error {
+	if assetCipher != nil {
+		return nil
+	}
+	salt, err := base64.StdEncoding.DecodeString(encryptionSalt)
+	if err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase()
+	if err != nil {
+		return err
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, kdfIterations, 32)
+	if err != nil {
+		return err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	assetCipher, err = cipher.NewGCM(block)
+	return err
+}
+
+// plainSize returns the size of the asset once decrypted, as recorded in
+// the manifest.
+func plainSize(name string, info os.FileInfo) int64 {
+	if !encrypted() {
+		return info.Size()
+	}
+	for _, e := range manifest {
+		if e.Name == name && e.Type == entryFile {
+			return e.Size
+		}
+	}
+	return -1
+}
+
+// decryptAsset returns the plain contents of an asset, which is data
+// itself unless the bundle is encrypted.
+func decryptAsset(name string, data []byte) ([]byte, error) {
+	if !encrypted() {
+		return data, nil
+	}
+	if err := initCipher(); err != nil {
+		return nil, err
+	}
+	n := assetCipher.NonceSize()
+	if len(data) < n {
+		return nil, fmt.Errorf("asset %s: too short to decrypt", name)
+	}
+	plain, err := assetCipher.Open(nil, data[:n], data[n:], []byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("asset %s: cannot decrypt, wrong key?", name)
+	}
+	return plain, nil
+}
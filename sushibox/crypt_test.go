@@ -0,0 +1,91 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// encryptFixtures points the mock at encrypted copies of the fixtures,
+// laid out the way sushimaster -encrypt stages them.
+func (suite *SushiboxTestSuite) encryptFixtures(passphrase string) {
+	entries := []manifestEntry{suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo")}
+	salt := make([]byte, 16)
+	rand.Read(salt)
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, kdfIterations, 32)
+	suite.Nil(err)
+	block, _ := aes.NewCipher(key)
+	gcm, _ := cipher.NewGCM(block)
+
+	dir := filepath.Join(suite.tempDir, "payload")
+	for i, e := range entries {
+		src := filepath.Join(mockDir, e.Name)
+		info, _ := os.Stat(src)
+		data, _ := ioutil.ReadFile(src)
+		entries[i].Size = info.Size()
+
+		nonce := make([]byte, gcm.NonceSize())
+		rand.Read(nonce)
+		dst := filepath.Join(dir, e.Name)
+		suite.Nil(os.MkdirAll(filepath.Dir(dst), os.FileMode(0700)))
+		suite.Nil(ioutil.WriteFile(dst, gcm.Seal(nonce, nonce, data, []byte(e.Name)), info.Mode()))
+		suite.Nil(os.Chtimes(dst, info.ModTime(), info.ModTime()))
+	}
+	suite.setManifest(entries...)
+	mockDir = dir
+	encryptionSalt = base64.StdEncoding.EncodeToString(salt)
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEncrypted() {
+	suite.encryptFixtures("secret")
+	os.Setenv(keyEnv, "secret")
+	suite.Nil(restoreFiles())
+	suite.Nil(checkFilesInfo())
+
+	stdout, _, err := execCmd("foo", []string{})
+	suite.Equal("foo\n", string(stdout))
+	suite.Nil(err)
+
+	info, _ := os.Stat(BaseDir)
+	suite.Equal(os.ModeDir|0700, info.Mode())
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEncryptedKeyFile() {
+	suite.encryptFixtures("secret")
+	path := filepath.Join(suite.tempDir, "key")
+	suite.Nil(ioutil.WriteFile(path, []byte("secret\n"), os.FileMode(0600)))
+	os.Setenv(keyFileEnv, path)
+	suite.Nil(restoreFiles())
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEncryptedEmptyKeyFile() {
+	suite.encryptFixtures("secret")
+	path := filepath.Join(suite.tempDir, "key")
+	suite.Nil(ioutil.WriteFile(path, []byte("\n"), os.FileMode(0600)))
+	os.Setenv(keyFileEnv, path)
+	_, err := readPassphrase()
+	suite.NotNil(err)
+	suite.NotNil(restoreFiles())
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEncryptedWrongKey() {
+	suite.encryptFixtures("secret")
+	os.Setenv(keyEnv, "wrong")
+	suite.NotNil(restoreFiles())
+	_, err := os.Stat(BaseDir)
+	suite.True(os.IsNotExist(err))
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEncryptedSwapped() {
+	suite.encryptFixtures("secret")
+	os.Setenv(keyEnv, "secret")
+	foo, _ := ioutil.ReadFile(filepath.Join(mockDir, "bin", "foo"))
+	suite.Nil(ioutil.WriteFile(filepath.Join(mockDir, "bin", "bar"), foo, os.FileMode(0644)))
+	suite.NotNil(restoreFiles())
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SUSHIBOX_DEBUG turns on structured logging of what the runtime does:
+// 1 (or debug) for the decisions it takes, 2 (or trace) also for every
+// file it checks. Logs go to stderr as text, or to SUSHIBOX_DEBUG_FILE as
+// JSON lines.
+const (
+	debugEnv     = "SUSHIBOX_DEBUG"
+	debugFileEnv = "SUSHIBOX_DEBUG_FILE"
+)
+
+const levelTrace = slog.LevelDebug - 4
+
+var logger = slog.New(slog.DiscardHandler)
+
+func debugLevel(s string) (slog.Level, bool, error) {
+	switch strings.ToLower(s) {
+	case "", "0", "off":
+		return 0, false, nil
+	case "1", "debug":
+		return slog.LevelDebug, true, nil
+	case "2", "trace":
+		return levelTrace, true, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n > 2 {
+		return levelTrace, true, nil
+	}
+	return 0, false, fmt.Errorf("invalid %s %q", debugEnv, s)
+}
+
+// initDebug sets up logger from the environment. The returned func closes
+// the log file, if any.
+func initDebug() (func(), error) {
+	level, on, err := debugLevel(os.Getenv(debugEnv))
+	if err != nil || !on {
+		return func() {}, err
+	}
+	opts := &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.LevelKey && a.Value.Any().(slog.Level) == levelTrace {
+				a.Value = slog.StringValue("TRACE")
+			}
+			return a
+		},
+	}
+
+	path := os.Getenv(debugFileEnv)
+	if path == "" {
+		logger = slog.New(slog.NewTextHandler(os.Stderr, opts))
+		return func() {}, nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, os.FileMode(0600))
+	if err != nil {
+		return func() {}, err
+	}
+	logger = slog.New(slog.NewJSONHandler(io.Writer(f), opts)).With("pid", os.Getpid())
+	return func() { f.Close() }, nil
+}
+
+func trace(msg string, args ...interface{}) {
+	logger.Log(context.Background(), levelTrace, msg, args...)
+}
+
+// mismatch logs which attribute of an extracted file differs from the
+// bundle and returns the error checkFilesInfo fails with.
+func mismatch(path, attr string, expected, actual interface{}) error {
+	logger.Debug("checkFilesInfo mismatch", "path", path, "attr", attr, "expected", expected, "actual", actual)
+	return fmt.Errorf("check info error %s: %s is different", path, attr)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func (suite *SushiboxTestSuite) TestDebugLevel() {
+	for s, expected := range map[string]slog.Level{"1": slog.LevelDebug, "debug": slog.LevelDebug, "2": levelTrace, "TRACE": levelTrace} {
+		level, on, err := debugLevel(s)
+		suite.Nil(err)
+		suite.True(on)
+		suite.Equal(expected, level, s)
+	}
+	_, on, err := debugLevel("")
+	suite.Nil(err)
+	suite.False(on)
+	_, _, err = debugLevel("verbose")
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestDebugCheckFilesInfoMismatch() {
+	var buf bytes.Buffer
+	logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	suite.Nil(restoreFiles())
+	foo := filepath.Join(BinDir, "foo")
+	suite.Nil(os.Chtimes(foo, time.Unix(0, 0), time.Unix(0, 0)))
+	suite.NotNil(checkFilesInfo())
+	suite.Contains(buf.String(), "msg=restoreFiles ")
+	suite.Contains(buf.String(), "msg=\"checkFilesInfo mismatch\" path="+foo+" attr=mtime")
+}
+
+func (suite *SushiboxTestSuite) TestDebugExec() {
+	var buf bytes.Buffer
+	logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	suite.Nil(restoreFiles())
+	_, _, err := execCmd("foo", []string{"a"})
+	suite.Nil(err)
+	suite.Contains(buf.String(), "msg=exec path="+filepath.Join(BinDir, "foo")+" argv=\"["+filepath.Join(BinDir, "foo")+" a]\"")
+}
+
+func (suite *SushiboxTestSuite) TestInitDebugFile() {
+	path := filepath.Join(suite.tempDir, "debug.log")
+	os.Setenv(debugEnv, "trace")
+	os.Setenv(debugFileEnv, path)
+	defer os.Unsetenv(debugEnv)
+	defer os.Unsetenv(debugFileEnv)
+
+	closeDebug, err := initDebug()
+	suite.Nil(err)
+	trace("hello", "n", 1)
+	closeDebug()
+
+	buf, err := ioutil.ReadFile(path)
+	suite.Nil(err)
+	suite.Contains(string(buf), `"level":"TRACE","msg":"hello"`)
+	suite.Contains(string(buf), `"n":1`)
+}
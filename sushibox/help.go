@@ -0,0 +1,141 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+)
+
+// helpDir holds the longer help of a command, help/<cmd>, which
+// "sushibox help <cmd>" prints after its description.
+const helpDir = "help"
+
+// helpCommand is the word which asks for help unless the bundle has a
+// command of that name.
+const helpCommand = "help"
+
+// commandEntry returns the manifest entry describing cmd. Links without a
+// description of their own are described by the command they point at.
+func commandEntry(cmd string) *manifestEntry {
+	name := "bin/" + cmd
+	for depth := 0; depth < 2; depth++ {
+		var found *manifestEntry
+		for i, e := range manifest {
+			if e.Name == name {
+				found = &manifest[i]
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		switch {
+		case found.Description != "" || found.Type == entryFile:
+			return found
+		case found.Type == entrySymlink:
+			name = path.Join("bin", found.Target)
+		case found.Type == entryHardlink:
+			name = found.Target
+		}
+	}
+	return nil
+}
+
+// writeCatalog lists the bundled commands with their descriptions, the
+// ones without a group first.
+func writeCatalog(w io.Writer) {
+	commands, _ := bundledCommands()
+	groups := make(map[string][]string)
+	for _, cmd := range commands {
+		group := ""
+		if e := commandEntry(cmd); e != nil {
+			group = e.Group
+		}
+		groups[group] = append(groups[group], cmd)
+	}
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, group := range names {
+		title := group
+		if title == "" {
+			title = "Commands"
+		}
+		fmt.Fprintf(tw, "%s:\n", title)
+		for _, cmd := range groups[group] {
+			description := ""
+			if e := commandEntry(cmd); e != nil {
+				description = e.Description
+			}
+			fmt.Fprintf(tw, "  %s\t%s\n", cmd, description)
+		}
+		fmt.Fprintf(tw, "\n")
+	}
+	tw.Flush()
+}
+
+func usage() {
+	fmt.Printf("Usage: sushibox [options] command args...\n")
+	fmt.Printf("       sushibox help command\n\n")
+	writeCatalog(os.Stdout)
+	fmt.Printf("Options:\n")
+	flag.PrintDefaults()
+}
+
+// isHelp tells whether cmd asks for help rather than names a command.
+func isHelp(cmd string) bool {
+	if cmd != helpCommand {
+		return false
+	}
+	commands, _ := bundledCommands()
+	for _, c := range commands {
+		if c == helpCommand {
+			return false
+		}
+	}
+	return true
+}
+
+// printHelp prints the description of cmd and its help file, if the
+// bundle has one.
+func printHelp(w io.Writer, cmd string) error {
+	if !completableName.MatchString(cmd) {
+		return fmt.Errorf("unknown command %s", cmd)
+	}
+	e := commandEntry(cmd)
+	if _, err := os.Lstat(filepath.Join(BinDir, cmd)); e == nil && err != nil {
+		return fmt.Errorf("unknown command %s", cmd)
+	}
+	if e != nil && e.Description != "" {
+		fmt.Fprintf(w, "%s - %s\n", cmd, e.Description)
+	} else {
+		fmt.Fprintf(w, "%s\n", cmd)
+	}
+
+	p := filepath.Join(BaseDir, helpDir, cmd)
+	if _, err := os.Lstat(p); os.IsNotExist(err) {
+		return nil
+	}
+	if err := checkExecPath(p); err != nil {
+		return err
+	}
+	if err := verifyExecFile(p); err != nil {
+		return err
+	}
+	buf, err := ioutil.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\n%s", buf)
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) helpFixtures() {
+	suite.mockFixtures()
+	suite.mockFile("bin/bar", "#!/bin/sh\n", os.FileMode(0755))
+	suite.mockFile(helpDir+"/foo", "Usage: foo [-v]\n", os.FileMode(0644))
+
+	foo := suite.fixtureEntry("bin/foo")
+	foo.Description, foo.Group = "print foo", "Printing"
+	bar := suite.fixtureEntry("bin/bar")
+	bar.Description = "print bar"
+	suite.setManifest(bar, suite.fixtureEntry(helpDir+"/foo"), foo,
+		manifestEntry{Name: "bin/qux", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "foo"},
+	)
+}
+
+func (suite *SushiboxTestSuite) TestWriteCatalog() {
+	suite.helpFixtures()
+
+	var buf bytes.Buffer
+	writeCatalog(&buf)
+	suite.Equal("Commands:\n  bar  print bar\n\nPrinting:\n  foo  print foo\n  qux  print foo\n\n", buf.String())
+}
+
+func (suite *SushiboxTestSuite) TestPrintHelp() {
+	suite.helpFixtures()
+	suite.Nil(restoreFiles())
+
+	var buf bytes.Buffer
+	suite.Nil(printHelp(&buf, "foo"))
+	suite.Equal("foo - print foo\n\nUsage: foo [-v]\n", buf.String())
+
+	buf.Reset()
+	suite.Nil(printHelp(&buf, "bar"))
+	suite.Equal("bar - print bar\n", buf.String())
+
+	suite.NotNil(printHelp(&buf, "nothing"))
+	suite.NotNil(printHelp(&buf, "../help/foo"))
+}
+
+func (suite *SushiboxTestSuite) TestPrintHelpTampered() {
+	suite.helpFixtures()
+	suite.Nil(restoreFiles())
+	path := filepath.Join(BaseDir, helpDir, "foo")
+	suite.Nil(os.Chmod(path, 0644))
+	suite.Nil(ioutil.WriteFile(path, []byte("tampered\n"), 0644))
+
+	suite.NotNil(printHelp(ioutil.Discard, "foo"))
+}
+
+func (suite *SushiboxTestSuite) TestIsHelp() {
+	suite.helpFixtures()
+	suite.True(isHelp("help"))
+	suite.False(isHelp("foo"))
+
+	suite.mockFile("bin/help", "#!/bin/sh\n", os.FileMode(0755))
+	suite.False(isHelp("help"))
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// Hooks are optional executables in the bundle run around every command.
+// A failing pre-exec hook stops the command from running. A post-exec hook
+// needs sushibox to outlive the command, so it makes the command run
+// supervised.
+const (
+	hookPreExec  = "hooks/pre-exec"
+	hookPostExec = "hooks/post-exec"
+)
+
+const (
+	hookCommandEnv    = "SUSHIBOX_COMMAND"
+	hookArgsEnv       = "SUSHIBOX_ARGS"
+	hookExitStatusEnv = "SUSHIBOX_EXIT_STATUS"
+)
+
+func hookPath(name string) string {
+	return filepath.Join(BaseDir, filepath.FromSlash(name))
+}
+
+func hookExists(name string) bool {
+	_, err := os.Lstat(hookPath(name))
+	return err == nil
+}
+
+// runHook runs the hook name, if the bundle has it, with the command and
+// its arguments (as a JSON array) in its environment. Its output goes to
+// stderr so that it does not mix with the output of the command.
+func runHook(name, cmd string, args, envv []string, extra ...string) error {
+	if !hookExists(name) {
+		return nil
+	}
+	p := hookPath(name)
+	if err := checkExecPath(p); err != nil {
+		return err
+	}
+	if err := verifyExecFile(p); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	envv = setEnv(envv, hookCommandEnv, cmd)
+	envv = setEnv(envv, hookArgsEnv, string(encoded))
+	envv = append(envv, extra...)
+
+	hook := &exec.Cmd{Path: p, Args: []string{p}, Env: envv, Stdin: os.Stdin, Stdout: os.Stderr, Stderr: os.Stderr}
+	if err := hook.Run(); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+// exitStatus is what a post-exec hook is told about how the command ended.
+func exitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	if exit, ok := err.(*childExit); ok {
+		return exit.code()
+	}
+	return 127
+}
+
+func runPostHook(cmd string, args, envv []string, err error) {
+	status := hookExitStatusEnv + "=" + strconv.Itoa(exitStatus(err))
+	if hookErr := runHook(hookPostExec, cmd, args, envv, status); hookErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %+v\n", hookErr)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) hookFixtures(pre, post string) string {
+	log := filepath.Join(suite.tempDir, "log")
+	suite.mockFixtures()
+	suite.mockFile("bin/foo", "#!/bin/sh\necho \"foo $*\" >> "+log+"\nexit 4\n", os.FileMode(0755))
+	if pre != "" {
+		suite.mockFile("hooks/pre-exec", "#!/bin/sh\n"+pre, os.FileMode(0755))
+	}
+	if post != "" {
+		suite.mockFile("hooks/post-exec", "#!/bin/sh\n"+post, os.FileMode(0755))
+	}
+	return log
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdHooks() {
+	log := suite.hookFixtures(
+		"echo \"pre $SUSHIBOX_COMMAND $SUSHIBOX_ARGS\" >> "+filepath.Join(suite.tempDir, "log")+"\n",
+		"echo \"post $SUSHIBOX_COMMAND $SUSHIBOX_EXIT_STATUS\" >> "+filepath.Join(suite.tempDir, "log")+"\n",
+	)
+	suite.Nil(restoreFiles())
+
+	_, _, err := execCmd("foo", []string{"a b", "c"})
+	exit, ok := err.(*childExit)
+	suite.True(ok)
+	suite.Equal(4, exit.code())
+
+	buf, _ := ioutil.ReadFile(log)
+	suite.Equal("pre foo [\"a b\",\"c\"]\nfoo a b c\npost foo 4\n", string(buf))
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdPreHookFails() {
+	log := suite.hookFixtures("exit 1\n", "")
+	suite.Nil(restoreFiles())
+
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+	_, err = os.Stat(log)
+	suite.True(os.IsNotExist(err))
+}
+
+func (suite *SushiboxTestSuite) TestExitStatus() {
+	suite.Equal(0, exitStatus(nil))
+	suite.Equal(127, exitStatus(os.ErrNotExist))
+}
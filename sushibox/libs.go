@@ -0,0 +1,38 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// libDir is replaced by sushimaster with the directory of bundled shared
+// libraries when the bundle is built with -bundle-libs.
+var libDir = ""
+
+const libraryPathEnv = "LD_LIBRARY_PATH"
+
+// prependPathEnv returns envv with dir put in front of the list in key.
+func prependPathEnv(envv []string, key, dir string) []string {
+	prefix := key + "="
+	for i, kv := range envv {
+		if strings.HasPrefix(kv, prefix) {
+			value := strings.TrimPrefix(kv, prefix)
+			if value != "" {
+				dir += string(os.PathListSeparator) + value
+			}
+			out := append([]string{}, envv...)
+			out[i] = prefix + dir
+			return out
+		}
+	}
+	return append(envv, prefix+dir)
+}
+
+// libraryEnv makes the bundled libraries visible to the dynamic loader.
+func libraryEnv(envv []string) []string {
+	if libDir == "" {
+		return envv
+	}
+	return prependPathEnv(envv, libraryPathEnv, filepath.Join(BaseDir, filepath.FromSlash(libDir)))
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) TestLibraryEnv() {
+	envv := []string{"PATH=/bin"}
+	suite.Equal(envv, libraryEnv(envv))
+
+	libDir = "lib"
+	lib := filepath.Join(BaseDir, "lib")
+	suite.Equal([]string{"PATH=/bin", "LD_LIBRARY_PATH=" + lib}, libraryEnv(envv))
+	suite.Equal([]string{"LD_LIBRARY_PATH=" + lib + ":/opt/lib"}, libraryEnv([]string{"LD_LIBRARY_PATH=/opt/lib"}))
+	suite.Equal([]string{"LD_LIBRARY_PATH=" + lib}, libraryEnv([]string{"LD_LIBRARY_PATH="}))
+}
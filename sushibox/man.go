@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// manDir is where a bundle keeps its man pages, in the usual man<section>
+// layout.
+const manDir = "share/man"
+
+const manPathEnv = "MANPATH"
+
+// manEnv makes the bundled man pages visible to man. An unset MANPATH
+// gets a trailing separator so that man still searches its default path.
+func manEnv(envv []string) []string {
+	dir := filepath.Join(BaseDir, filepath.FromSlash(manDir))
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return envv
+	}
+	for _, kv := range envv {
+		if strings.HasPrefix(kv, manPathEnv+"=") && kv != manPathEnv+"=" {
+			return prependPathEnv(envv, manPathEnv, dir)
+		}
+	}
+	return setEnv(envv, manPathEnv, dir+string(os.PathListSeparator))
+}
+
+// findManPage returns the bundled page of cmd, preferring the lowest
+// section.
+func findManPage(cmd string) (string, error) {
+	if !completableName.MatchString(cmd) {
+		return "", fmt.Errorf("invalid command %s", cmd)
+	}
+	page := regexp.MustCompile(`^` + regexp.QuoteMeta(cmd) + `\.[0-9][a-z]*(\.gz)?$`)
+	sections, _ := filepath.Glob(filepath.Join(BaseDir, filepath.FromSlash(manDir), "man*"))
+	sort.Slice(sections, func(i, j int) bool {
+		a, b := manSection(sections[i]), manSection(sections[j])
+		if a != b {
+			return a < b
+		}
+		return sections[i] < sections[j]
+	})
+	for _, section := range sections {
+		list, err := ioutil.ReadDir(section)
+		if err != nil {
+			continue
+		}
+		for _, fi := range list {
+			if page.MatchString(fi.Name()) {
+				return filepath.Join(section, fi.Name()), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no man page for %s", cmd)
+}
+
+// manSection returns the number of a man<section> directory, so that
+// man10 sorts after man2. Directories without one sort last.
+func manSection(dir string) int {
+	digits := strings.TrimPrefix(filepath.Base(dir), "man")
+	if i := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		digits = digits[:i]
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return math.MaxInt32
+	}
+	return n
+}
+
+// manFunc shows a page with the man of the host, which pages it itself.
+var manFunc = func(p string) error {
+	man, err := exec.LookPath("man")
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(man, p)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// showManPage shows the page of cmd through man, or as plain text on w
+// when the host has no man.
+func showManPage(w io.Writer, cmd string) error {
+	p, err := findManPage(cmd)
+	if err != nil {
+		return err
+	}
+	if err := checkExecPath(p); err != nil {
+		return err
+	}
+	if err := verifyExecFile(p); err != nil {
+		return err
+	}
+	err = manFunc(p)
+	if !errors.Is(err, exec.ErrNotFound) {
+		return err
+	}
+	logger.Debug("man not found, falling back to plain text", "page", p)
+	return writePlainManPage(w, p)
+}
+
+var (
+	roffFont   = regexp.MustCompile(`\\f(\[[A-Z]*\]|\(..|.)`)
+	roffEscape = strings.NewReplacer(`\-`, "-", `\(em`, "--", `\(en`, "-", `\e`, `\`, `\&`, "", `\ `, " ")
+)
+
+// writePlainManPage writes the text of a roff page without formatting:
+// requests are dropped except for headings and the text of font macros.
+func writePlainManPage(w io.Writer, p string) error {
+	buf, err := ioutil.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(p, ".gz") {
+		r, err := gzip.NewReader(bytes.NewReader(buf))
+		if err != nil {
+			return err
+		}
+		if buf, err = ioutil.ReadAll(r); err != nil {
+			return err
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			fields := strings.SplitN(line[1:], " ", 2)
+			rest := ""
+			if len(fields) == 2 {
+				rest = strings.Replace(strings.TrimSpace(fields[1]), `"`, "", -1)
+			}
+			switch strings.TrimSpace(fields[0]) {
+			case "SH":
+				fmt.Fprintf(w, "\n%s\n", rest)
+			case "SS":
+				fmt.Fprintf(w, "\n  %s\n", rest)
+			case "B", "I", "BR", "BI", "IR", "IB", "RB", "RI":
+				fmt.Fprintf(w, "       %s\n", roffEscape.Replace(roffFont.ReplaceAllString(rest, "")))
+			case "PP", "P", "LP", "TP", "IP", "sp", "br":
+				fmt.Fprintf(w, "\n")
+			}
+			continue
+		}
+		fmt.Fprintf(w, "       %s\n", roffEscape.Replace(roffFont.ReplaceAllString(line, "")))
+	}
+	return scanner.Err()
+}
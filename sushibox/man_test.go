@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+var realManFunc = manFunc
+
+const fooPage = `.TH FOO 1
+.SH NAME
+foo \- print foo
+.SH SYNOPSIS
+.B foo
+[\fB\-v\fR]
+`
+
+func (suite *SushiboxTestSuite) manFixtures() {
+	suite.mockFixtures()
+	suite.mockFile("share/man/man5/foo.5", ".TH FOO 5\n", os.FileMode(0644))
+	suite.mockFile("share/man/man5/foo.conf.5", ".TH FOO.CONF 5\n", os.FileMode(0644))
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	fmt.Fprint(gz, fooPage)
+	suite.Nil(gz.Close())
+	suite.mockFile("share/man/man1/foo.1.gz", buf.String(), os.FileMode(0644))
+}
+
+func (suite *SushiboxTestSuite) TestManEnv() {
+	envv := []string{"PATH=/bin"}
+	suite.Equal(envv, manEnv(envv))
+
+	suite.manFixtures()
+	suite.Nil(restoreFiles())
+	dir := filepath.Join(BaseDir, "share", "man")
+	suite.Equal([]string{"PATH=/bin", "MANPATH=" + dir + ":"}, manEnv(envv))
+	suite.Equal([]string{"MANPATH=" + dir + ":"}, manEnv([]string{"MANPATH="}))
+	suite.Equal([]string{"MANPATH=" + dir + ":/usr/share/man"}, manEnv([]string{"MANPATH=/usr/share/man"}))
+}
+
+func (suite *SushiboxTestSuite) TestFindManPage() {
+	suite.manFixtures()
+	suite.Nil(restoreFiles())
+
+	p, err := findManPage("foo")
+	suite.Nil(err)
+	suite.Equal(filepath.Join(BaseDir, "share", "man", "man1", "foo.1.gz"), p)
+	p, err = findManPage("foo.conf")
+	suite.Nil(err)
+	suite.Equal(filepath.Join(BaseDir, "share", "man", "man5", "foo.conf.5"), p)
+	_, err = findManPage("bar")
+	suite.NotNil(err)
+	_, err = findManPage("../foo")
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestShowManPagePlain() {
+	suite.manFixtures()
+	suite.Nil(restoreFiles())
+	var shown string
+	manFunc = func(p string) error {
+		shown = p
+		return &exec.Error{Name: "man", Err: exec.ErrNotFound}
+	}
+
+	var buf bytes.Buffer
+	suite.Nil(showManPage(&buf, "foo"))
+	suite.Equal(filepath.Join(BaseDir, "share", "man", "man1", "foo.1.gz"), shown)
+	suite.Equal("\nNAME\n       foo - print foo\n\nSYNOPSIS\n       foo\n       [-v]\n", buf.String())
+}
+
+func (suite *SushiboxTestSuite) TestShowManPageFailed() {
+	suite.manFixtures()
+	suite.Nil(restoreFiles())
+	manFunc = func(p string) error {
+		return fmt.Errorf("exit status 16")
+	}
+
+	// Only a missing man falls back to plain text.
+	var buf bytes.Buffer
+	suite.NotNil(showManPage(&buf, "foo"))
+	suite.Equal("", buf.String())
+}
+
+func (suite *SushiboxTestSuite) TestFindManPageSectionOrder() {
+	suite.manFixtures()
+	suite.mockFile("share/man/man10/bar.10", ".TH BAR 10\n", os.FileMode(0644))
+	suite.mockFile("share/man/man2/bar.2", ".TH BAR 2\n", os.FileMode(0644))
+	suite.Nil(restoreFiles())
+
+	p, err := findManPage("bar")
+	suite.Nil(err)
+	suite.Equal(filepath.Join(BaseDir, "share", "man", "man2", "bar.2"), p)
+	suite.Equal(2, manSection("man2"))
+	suite.Equal(3, manSection("man3p"))
+	suite.True(manSection("mann") > manSection("man10"))
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// manifestJSON is replaced by sushimaster with the entries of the input tree.
+var manifestJSON = ""
+
+var manifest []manifestEntry
+
+type manifestEntry struct {
+	Name   string      `json:"name"`
+	Type   string      `json:"type"`
+	Mode   os.FileMode `json:"mode"`
+	Target string      `json:"target,omitempty"`
+	Size   int64       `json:"size,omitempty"`
+	Hash   string      `json:"hash,omitempty"`
+
+	Interpreter     string   `json:"interpreter,omitempty"`
+	InterpreterArgs []string `json:"args,omitempty"`
+
+	Description string `json:"description,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+const (
+	entryFile     = "file"
+	entryDir      = "dir"
+	entrySymlink  = "symlink"
+	entryHardlink = "hardlink"
+)
+
+func loadManifest() error {
+	manifest = nil
+	if manifestJSON == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(manifestJSON), &manifest)
+}
+
+// restoreEntries recreates the directories, symlinks and hardlinks
+// recorded in the manifest under dir. Regular files must already be
+// restored so that hardlinks have something to point at.
+func restoreEntries(dir string) error {
+	links := symlinkTargets(manifest)
+	for _, e := range manifest {
+		p, err := assetPath(dir, e.Name)
+		if err != nil {
+			return err
+		}
+		switch e.Type {
+		case entryFile:
+			if err := os.Chmod(p, restoreMode(e.Mode.Perm())); err != nil {
+				return err
+			}
+		case entryDir:
+			if err := os.MkdirAll(p, os.FileMode(0700)); err != nil {
+				return err
+			}
+		case entrySymlink:
+			if !linkWithinRoot(links, e.Name, e.Target) {
+				return fmt.Errorf("symlink %s -> %s escapes the bundle", e.Name, e.Target)
+			}
+			if err := os.Symlink(filepath.FromSlash(e.Target), p); err != nil {
+				return err
+			}
+		case entryHardlink:
+			target, err := assetPath(dir, e.Target)
+			if err != nil {
+				return err
+			}
+			if err := os.Link(target, p); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown manifest entry type %s: %s", e.Type, e.Name)
+		}
+	}
+
+	// Directory modes are applied last, deepest first, so that read-only
+	// directories do not block restoring their children.
+	for i := len(manifest) - 1; i >= 0; i-- {
+		e := manifest[i]
+		if e.Type != entryDir {
+			continue
+		}
+		if err := os.Chmod(filepath.Join(dir, filepath.FromSlash(e.Name)), restoreMode(e.Mode.Perm())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkEntriesInfo() error {
+	for _, e := range manifest {
+		p, err := assetPath(BaseDir, e.Name)
+		if err != nil {
+			return err
+		}
+		fileInfo, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+
+		switch e.Type {
+		case entryDir, entrySymlink:
+			mode := e.Mode
+			if e.Type == entryDir {
+				mode = restoreMode(mode)
+			}
+			if fileInfo.Mode() != mode {
+				return mismatch(p, "mode", mode, fileInfo.Mode())
+			}
+			if e.Type == entrySymlink {
+				target, err := os.Readlink(p)
+				if err != nil {
+					return err
+				}
+				if filepath.ToSlash(target) != e.Target {
+					return mismatch(p, "link target", e.Target, filepath.ToSlash(target))
+				}
+			}
+		case entryHardlink:
+			target, err := assetPath(BaseDir, e.Target)
+			if err != nil {
+				return err
+			}
+			targetInfo, err := os.Lstat(target)
+			if err != nil {
+				return err
+			}
+			if !os.SameFile(fileInfo, targetInfo) {
+				return mismatch(p, "hardlink", e.Target, inodeOf(fileInfo))
+			}
+		}
+	}
+	return nil
+}
+
+// inodeOf describes which file info is, for a hardlink which points
+// elsewhere than expected.
+func inodeOf(info os.FileInfo) string {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return fmt.Sprintf("inode %d with %d links", st.Ino, st.Nlink)
+	}
+	return info.Name()
+}
+
+// maxLinkHops bounds how many symlinks a target may go through, as the
+// kernel does before giving up with ELOOP.
+const maxLinkHops = 40
+
+// symlinkTargets maps the name of every symlink entry to its target.
+func symlinkTargets(entries []manifestEntry) map[string]string {
+	links := make(map[string]string)
+	for _, e := range entries {
+		if e.Type == entrySymlink {
+			links[e.Name] = e.Target
+		}
+	}
+	return links
+}
+
+// linkWithinRoot reports whether a relative symlink target, resolved
+// from the directory holding name, stays inside the bundle root. Like
+// the kernel, it follows the symlinks in links met on the way, so that a
+// ".." after one of them is taken from where that link leads.
+func linkWithinRoot(links map[string]string, name, target string) bool {
+	hops := 0
+	var walk func(dir []string, target string) ([]string, bool)
+	walk = func(dir []string, target string) ([]string, bool) {
+		if target == "" || path.IsAbs(target) {
+			return nil, false
+		}
+		cur := append([]string{}, dir...)
+		for _, c := range strings.Split(target, "/") {
+			switch c {
+			case "", ".":
+				continue
+			case "..":
+				if len(cur) == 0 {
+					return nil, false
+				}
+				cur = cur[:len(cur)-1]
+				continue
+			}
+			cur = append(cur, c)
+			next, ok := links[strings.Join(cur, "/")]
+			if !ok {
+				continue
+			}
+			if hops++; hops > maxLinkHops {
+				return nil, false
+			}
+			if cur, ok = walk(cur[:len(cur)-1], next); !ok {
+				return nil, false
+			}
+		}
+		return cur, true
+	}
+
+	var dir []string
+	if d := path.Dir(name); d != "." {
+		dir = strings.Split(d, "/")
+	}
+	_, ok := walk(dir, target)
+	return ok
+}
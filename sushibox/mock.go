@@ -15,6 +15,10 @@ const mockDirEnv = "SUSHIBOX_MOCK"
 
 var mockDir = os.Getenv(mockDirEnv)
 
+// mockNames overrides the result of AssetNames when set, so that tests
+// can feed names which could never exist under mockDir.
+var mockNames []string
+
 var execMockFunc = func(arg0 string, argv, envv []string) (stdout, stderr []byte, err error) {
 	cmd := exec.Command(arg0, argv[1:]...)
 	cmd.Env = envv
@@ -52,6 +56,9 @@ func AssetInfo(name string) (os.FileInfo, error) {
 }
 
 func AssetNames() (names []string) {
+	if mockNames != nil {
+		return append(names, mockNames...)
+	}
 	if mockDir == "" {
 		errorExit("Please specify src directory by %s", mockDirEnv)
 	}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// pinEnv, or -use, runs commands from an earlier extraction instead of
+// the payload of this binary.
+const pinEnv = "SUSHIBOX_PIN"
+
+type extractedVersion struct {
+	Dir      string    `json:"dir"`
+	Version  string    `json:"version"`
+	Payload  string    `json:"payload,omitempty"`
+	Size     int64     `json:"size"`
+	LastUsed time.Time `json:"last_used"`
+	Current  bool      `json:"current"`
+
+	manifest, signature, key string
+}
+
+// listVersions returns the extractions under VersionsDir, most recently
+// used first.
+func listVersions() ([]extractedVersion, error) {
+	list, err := ioutil.ReadDir(VersionsDir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []extractedVersion
+	for _, fi := range list {
+		// Skip version info files and extractions in progress.
+		if !fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		dir := filepath.Join(VersionsDir, fi.Name())
+		v := extractedVersion{Dir: fi.Name(), Version: fi.Name(), LastUsed: fi.ModTime(), Current: fi.Name() == versionDirName()}
+		if info, err := os.Stat(dir + ".json"); err == nil {
+			v.LastUsed = info.ModTime()
+			var vi versionInfo
+			if buf, err := ioutil.ReadFile(dir + ".json"); err == nil && json.Unmarshal(buf, &vi) == nil {
+				v.Version, v.Payload, v.manifest, v.signature, v.key = vi.Version, vi.Payload, vi.Manifest, vi.Signature, vi.Key
+			}
+		}
+		filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+			if err == nil && info.Mode().IsRegular() {
+				v.Size += info.Size()
+			}
+			return nil
+		})
+		versions = append(versions, v)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].LastUsed.After(versions[j].LastUsed)
+	})
+	return versions, nil
+}
+
+// touchVersion records that the extraction at BaseDir was used.
+func touchVersion() {
+	now := time.Now()
+	os.Chtimes(BaseDir+".json", now, now)
+}
+
+// findVersion resolves a pin, which is a build version, a directory name
+// or a unique prefix of a payload hash.
+func findVersion(pin string) (*extractedVersion, error) {
+	versions, err := listVersions()
+	if err != nil {
+		return nil, err
+	}
+	var found []extractedVersion
+	for _, v := range versions {
+		if v.Version == pin || v.Dir == pin {
+			return &v, nil
+		}
+		if v.Payload != "" && strings.HasPrefix(v.Payload, pin) {
+			found = append(found, v)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("version %s is not extracted", pin)
+	case 1:
+		return &found[0], nil
+	}
+	return nil, fmt.Errorf("version %s is ambiguous", pin)
+}
+
+// pinnedVersion returns the pin given by -use or SUSHIBOX_PIN.
+func pinnedVersion() string {
+	if *use != "" {
+		return *use
+	}
+	return os.Getenv(pinEnv)
+}
+
+// usePinnedVersion switches BaseDir to an earlier extraction, along with
+// the manifest it was extracted from. The manifest has to be signed by the
+// key this binary trusts, like its own. It returns false when the pin is
+// the payload of this binary, which then is checked as usual.
+func usePinnedVersion(pin string) (bool, error) {
+	v, err := findVersion(pin)
+	if err != nil {
+		return false, err
+	}
+	if v.Current {
+		return false, nil
+	}
+	logger.Debug("usePinnedVersion", "pin", pin, "dir", v.Dir, "version", v.Version)
+	BaseDir = filepath.Join(VersionsDir, v.Dir)
+	BinDir = filepath.Join(BaseDir, "bin")
+	if err := checkSecureTree(SushiBoxDir, BaseDir); err != nil {
+		return false, err
+	}
+	manifestJSON, manifestSignature = v.manifest, v.signature
+	if err := verifySignature(); err != nil {
+		if v.key != signingKey {
+			return false, fmt.Errorf("version %s was signed with another key: %v", v.Version, err)
+		}
+		return false, err
+	}
+	return true, loadManifest()
+}
+
+func printVersions(w io.Writer, asJSON bool) error {
+	versions, err := listVersions()
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		if versions == nil {
+			versions = []extractedVersion{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(versions)
+	}
+	for _, v := range versions {
+		mark := " "
+		if v.Current {
+			mark = "*"
+		}
+		fmt.Fprintf(w, "%s %-20s %10d %s %s\n", mark, v.Version, v.Size, v.LastUsed.Format("2006-01-02 15:04:05"), v.Dir)
+	}
+	return nil
+}
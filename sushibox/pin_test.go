@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func (suite *SushiboxTestSuite) restoreVersion(payload string) {
+	PayloadHash = payload
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+}
+
+func (suite *SushiboxTestSuite) TestListVersions() {
+	suite.setManifest(suite.fixtureEntry("bin/foo"))
+	suite.restoreVersion("v1")
+	suite.restoreVersion("v2")
+	old := time.Now().Add(-time.Hour)
+	suite.Nil(os.Chtimes(filepath.Join(VersionsDir, "v1.json"), old, old))
+
+	versions, err := listVersions()
+	suite.Nil(err)
+	suite.Equal(2, len(versions))
+	suite.Equal("v2", versions[0].Payload)
+	suite.True(versions[0].Current)
+	suite.Equal("v1", versions[1].Payload)
+	suite.False(versions[1].Current)
+	suite.True(versions[1].Size > 0)
+	suite.Equal(manifestJSON, versions[1].manifest)
+
+	var buf bytes.Buffer
+	suite.Nil(printVersions(&buf, true))
+	var decoded []extractedVersion
+	suite.Nil(json.Unmarshal(buf.Bytes(), &decoded))
+	suite.Equal(2, len(decoded))
+}
+
+func (suite *SushiboxTestSuite) TestFindVersion() {
+	suite.restoreVersion("abc123")
+	suite.restoreVersion("abd456")
+
+	v, err := findVersion("abc")
+	suite.Nil(err)
+	suite.Equal("abc123", v.Dir)
+	_, err = findVersion("ab")
+	suite.NotNil(err)
+	_, err = findVersion("ffff")
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestUsePinnedVersion() {
+	suite.setManifest(suite.fixtureEntry("bin/foo"))
+	suite.restoreVersion("v1")
+	suite.setManifest(suite.fixtureEntry("bin/bar"))
+	suite.restoreVersion("v2")
+
+	pinned, err := usePinnedVersion("v1")
+	suite.Nil(err)
+	suite.True(pinned)
+	suite.Equal(filepath.Join(VersionsDir, "v1"), BaseDir)
+	suite.Equal("bin/foo", manifest[0].Name)
+	suite.Nil(checkEntriesInfo())
+	stdout, _, err := execCmd("foo", []string{})
+	suite.Nil(err)
+	suite.Equal("foo\n", string(stdout))
+
+	suite.Nil(initDirs())
+	pinned, err = usePinnedVersion("v2")
+	suite.Nil(err)
+	suite.False(pinned)
+}
+
+func (suite *SushiboxTestSuite) TestPinnedVersionTampered() {
+	suite.setManifest(suite.fixtureEntry("bin/foo"))
+	suite.restoreVersion("v1")
+	suite.restoreVersion("v2")
+	path := filepath.Join(VersionsDir, "v1", "bin", "foo")
+	suite.Nil(os.Chmod(path, 0755))
+	suite.Nil(ioutil.WriteFile(path, []byte("#!/bin/sh\necho evil\n"), 0755))
+
+	_, err := usePinnedVersion("v1")
+	suite.Nil(err)
+	_, _, err = execCmd("foo", []string{})
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestPinnedVersionEnv() {
+	os.Setenv(pinEnv, "v1")
+	suite.Equal("v1", pinnedVersion())
+	*use = "v2"
+	defer func() { *use = "" }()
+	suite.Equal("v2", pinnedVersion())
+}
+
+func (suite *SushiboxTestSuite) TestUsePinnedVersionSigned() {
+	suite.signBundle(suite.fixtureEntry("bin/foo"), suite.fixtureEntry("bin/bar"))
+	suite.restoreVersion("v1")
+	suite.signBundle(suite.fixtureEntry("bin/foo"), suite.fixtureEntry("bin/bar"))
+	suite.restoreVersion("v3")
+	suite.restoreVersion("v2")
+
+	// v1 was signed with another key than the running bundle trusts.
+	_, err := usePinnedVersion("v1")
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "another key")
+
+	pinned, err := usePinnedVersion("v3")
+	suite.Nil(err)
+	suite.True(pinned)
+
+	// A manifest changed in the unsigned version info is refused.
+	suite.Nil(initDirs())
+	info := filepath.Join(VersionsDir, "v3.json")
+	var vi versionInfo
+	buf, err := ioutil.ReadFile(info)
+	suite.Nil(err)
+	suite.Nil(json.Unmarshal(buf, &vi))
+	vi.Manifest = strings.Replace(vi.Manifest, "bin/bar", "bin/baz", -1)
+	buf, err = json.Marshal(vi)
+	suite.Nil(err)
+	suite.Nil(ioutil.WriteFile(info, buf, os.FileMode(0600)))
+	_, err = usePinnedVersion("v3")
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "signature does not match")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// validAssetName rejects names that would resolve outside of the
+// directory they are restored into.
+func validAssetName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("invalid asset name: empty")
+	case strings.Contains(name, "\\"):
+		return fmt.Errorf("invalid asset name %q: contains backslash", name)
+	case path.IsAbs(name):
+		return fmt.Errorf("invalid asset name %q: absolute path", name)
+	case path.Clean(name) != name:
+		return fmt.Errorf("invalid asset name %q: not canonical", name)
+	case name == ".." || strings.HasPrefix(name, "../"):
+		return fmt.Errorf("invalid asset name %q: escapes the root", name)
+	}
+	return nil
+}
+
+// assetPath returns the path of the asset name under dir, or an error if
+// the name is not safe to restore.
+func assetPath(dir, name string) (string, error) {
+	if err := validAssetName(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, filepath.FromSlash(name)), nil
+}
+
+// restoreAsset writes a single asset under dir. Unlike the RestoreAsset
+// generated by go-bindata, it validates the name, creates parents
+// private to the user and applies the mode regardless of umask.
+func restoreAsset(dir, name string) error {
+	p, err := assetPath(dir, name)
+	if err != nil {
+		return err
+	}
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	data, err = decryptAsset(name, data)
+	if err != nil {
+		return err
+	}
+	err = verifyAssetData(name, data)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(p), os.FileMode(0700))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(p, data, restoreMode(info.Mode()))
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(p, restoreMode(info.Mode()))
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(p, info.ModTime(), info.ModTime())
+}
+
+// restoreAssets writes every asset under dir. All names are validated
+// before anything is written, and regular files are restored before any
+// symlink exists, so no write can be redirected outside of dir.
+func restoreAssets(dir string) error {
+	names := AssetNames()
+	sort.Strings(names)
+	for _, name := range names {
+		if err := validAssetName(name); err != nil {
+			return err
+		}
+	}
+	for _, e := range manifest {
+		if err := validAssetName(e.Name); err != nil {
+			return err
+		}
+		if e.Type == entryHardlink {
+			if err := validAssetName(e.Target); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range names {
+		if err := restoreAsset(dir, name); err != nil {
+			return err
+		}
+	}
+	if dedupeEnabled() {
+		if err := dedupeFiles(dir); err != nil {
+			return err
+		}
+	}
+	return restoreEntries(dir)
+}
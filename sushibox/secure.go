@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// insecureBits are never restored, so that nobody but the owner can
+// modify what sushibox is about to exec.
+const insecureBits = os.FileMode(0022)
+
+func restoreMode(mode os.FileMode) os.FileMode {
+	return mode &^ insecureBits
+}
+
+// checkSecurePath refuses a path that is not owned by the current user
+// or that is writable by group or others. Symlink modes are ignored as
+// they are always 0777.
+func checkSecurePath(p string) error {
+	info, err := os.Lstat(p)
+	if err != nil {
+		return err
+	}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok && int(st.Uid) != os.Getuid() {
+		return fmt.Errorf("insecure path %s: owned by uid %d, not %d", p, st.Uid, os.Getuid())
+	}
+	if info.Mode()&os.ModeSymlink == 0 && info.Mode()&insecureBits != 0 {
+		return fmt.Errorf("insecure path %s: mode %s is group or world writable", p, info.Mode())
+	}
+	return nil
+}
+
+// checkSecureTree checks root and every path component below it down to p.
+func checkSecureTree(root, p string) error {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		return err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return fmt.Errorf("insecure path %s: outside of %s", p, root)
+	}
+
+	cur := root
+	if err := checkSecurePath(cur); err != nil {
+		return err
+	}
+	if rel == "." {
+		return nil
+	}
+	for _, c := range strings.Split(rel, string(os.PathSeparator)) {
+		cur = filepath.Join(cur, c)
+		if err := checkSecurePath(cur); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkExecPath verifies the command and, if it is a symlink, what it
+// resolves to before it gets exec'd.
+func checkExecPath(cmdPath string) error {
+	if err := checkSecureTree(SushiBoxDir, cmdPath); err != nil {
+		return err
+	}
+	resolved, err := filepath.EvalSymlinks(cmdPath)
+	if err != nil {
+		return err
+	}
+	base, err := filepath.EvalSymlinks(BaseDir)
+	if err != nil {
+		return err
+	}
+	if err := checkSecureTree(base, resolved); err != nil {
+		return err
+	}
+	return nil
+}
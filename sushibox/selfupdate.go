@@ -0,0 +1,313 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A release source is a directory or an HTTP(S) URL with the builds of a
+// bundle and an index of them, signed with the key the bundle was signed
+// with. sushimaster publish maintains both.
+const (
+	releaseIndexFile = "index.json"
+	releaseSigFile   = "index.json.sig"
+)
+
+type releaseIndex struct {
+	Name     string    `json:"name,omitempty"`
+	Releases []release `json:"releases"`
+}
+
+type release struct {
+	Version   string `json:"version"`
+	Semver    string `json:"semver,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	File      string `json:"file"`
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+}
+
+// executablePath is the binary -self-update replaces.
+var executablePath = os.Executable
+
+var httpClient = &http.Client{Timeout: 5 * time.Minute}
+
+// fetchRelease reads name from source, which is a directory or a URL.
+func fetchRelease(source, name string) ([]byte, error) {
+	if err := validAssetName(name); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return ioutil.ReadFile(filepath.Join(source, filepath.FromSlash(name)))
+	}
+
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, name)
+	logger.Debug("fetchRelease", "url", u.String())
+	resp, err := httpClient.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", u, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// indexAttempts is how often the index and its signature are fetched
+// before a mismatch is taken as such, since sushimaster publish replaces
+// them one after the other and a reader may get one of each.
+const indexAttempts = 3
+
+var indexRetryDelay = time.Second
+
+// loadReleaseIndex fetches the index of source and checks its signature.
+// Only signed bundles can update themselves.
+func loadReleaseIndex(source string) (*releaseIndex, error) {
+	key, err := verifyKey()
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("self-update needs a bundle built with -sign-key or %s", verifyKeyEnv)
+	}
+	var buf []byte
+	for attempt := 1; ; attempt++ {
+		if buf, err = fetchRelease(source, releaseIndexFile); err != nil {
+			return nil, err
+		}
+		encoded, err := fetchRelease(source, releaseSigFile)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+		if err == nil && ed25519.Verify(key, buf, sig) {
+			break
+		}
+		if attempt == indexAttempts {
+			return nil, fmt.Errorf("release index signature does not match")
+		}
+		logger.Debug("release index signature does not match, retrying", "attempt", attempt)
+		time.Sleep(indexRetryDelay)
+	}
+	idx := &releaseIndex{}
+	if err := json.Unmarshal(buf, idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// compareSemver compares two semantic versions, ignoring build metadata.
+func compareSemver(a, b string) int {
+	split := func(v string) ([3]int, string) {
+		v = strings.TrimPrefix(v, "v")
+		if i := strings.Index(v, "+"); i >= 0 {
+			v = v[:i]
+		}
+		pre := ""
+		if i := strings.Index(v, "-"); i >= 0 {
+			v, pre = v[:i], v[i+1:]
+		}
+		var n [3]int
+		for i, p := range strings.SplitN(v, ".", 3) {
+			n[i], _ = strconv.Atoi(p)
+		}
+		return n, pre
+	}
+	an, apre := split(a)
+	bn, bpre := split(b)
+	for i := range an {
+		if an[i] != bn[i] {
+			if an[i] < bn[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case apre == bpre:
+		return 0
+	case apre == "":
+		return 1
+	case bpre == "":
+		return -1
+	}
+	return strings.Compare(apre, bpre)
+}
+
+// timestampVersion is the layout of build versions which are not the
+// payload hash of a reproducible build.
+const timestampVersion = "20060102-150405"
+
+// compareRelease orders releases by semantic version when both have one,
+// then by build time, then by build version when both are timestamps. The
+// hashes of reproducible builds do not sort, so releases which only differ
+// in them cannot be ordered.
+func compareRelease(a, b release) (int, error) {
+	if a.Semver != "" && b.Semver != "" {
+		if c := compareSemver(a.Semver, b.Semver); c != 0 {
+			return c, nil
+		}
+	}
+	if a.Version == b.Version {
+		return 0, nil
+	}
+	at, aerr := time.Parse(time.RFC3339, a.BuildTime)
+	bt, berr := time.Parse(time.RFC3339, b.BuildTime)
+	if aerr == nil && berr == nil && !at.Equal(bt) {
+		if at.Before(bt) {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	_, aerr = time.Parse(timestampVersion, a.Version)
+	_, berr = time.Parse(timestampVersion, b.Version)
+	if aerr != nil || berr != nil {
+		return 0, fmt.Errorf("cannot tell whether %s or %s is newer, build them with -semver", a.Version, b.Version)
+	}
+	return strings.Compare(a.Version, b.Version), nil
+}
+
+// newerRelease returns the newest release of the index if it is newer
+// than the running bundle.
+func newerRelease(idx *releaseIndex, info buildInfo) (*release, error) {
+	if idx.Name != "" && info.Name != "" && idx.Name != info.Name {
+		return nil, fmt.Errorf("release index is for %s, not %s", idx.Name, info.Name)
+	}
+	current := release{Version: info.Build, Semver: info.Version, BuildTime: info.BuildTime}
+	var newest *release
+	for i, r := range idx.Releases {
+		if newest != nil {
+			c, err := compareRelease(r, *newest)
+			if err != nil {
+				return nil, err
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+		newest = &idx.Releases[i]
+	}
+	if newest == nil {
+		return nil, nil
+	}
+	c, err := compareRelease(*newest, current)
+	if err != nil || c <= 0 {
+		return nil, err
+	}
+	return newest, nil
+}
+
+// installBinary atomically replaces exe with data, keeping the replaced
+// binary as exe.rollback.
+func installBinary(exe string, data []byte) (rollback string, err error) {
+	info, err := os.Stat(exe)
+	if err != nil {
+		return
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(exe), "."+filepath.Base(exe)+".new_")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+	if err = os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return
+	}
+
+	rollback = exe + ".rollback"
+	if err = os.Remove(rollback); err != nil && !os.IsNotExist(err) {
+		return
+	}
+	if err = os.Link(exe, rollback); err != nil {
+		if err = copyFile(exe, rollback, info.Mode().Perm()); err != nil {
+			return
+		}
+	}
+	err = os.Rename(tmp.Name(), exe)
+	return
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// selfUpdateFrom implements -self-update.
+func selfUpdateFrom(w io.Writer, source string) error {
+	info, err := loadBuildInfo()
+	if err != nil {
+		return err
+	}
+	idx, err := loadReleaseIndex(source)
+	if err != nil {
+		return err
+	}
+	r, err := newerRelease(idx, info)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		fmt.Fprintf(w, "%s is up to date\n", info.Build)
+		return nil
+	}
+
+	data, err := fetchRelease(source, r.File)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	if int64(len(data)) != r.Size || hex.EncodeToString(sum[:]) != r.SHA256 {
+		return fmt.Errorf("release %s: hash does not match index", r.Version)
+	}
+	exe, err := executablePath()
+	if err != nil {
+		return err
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return err
+	}
+	rollback, err := installBinary(exe, data)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "updated %s from %s to %s, previous binary kept as %s\n", exe, info.Build, r.Version, rollback)
+	return nil
+}
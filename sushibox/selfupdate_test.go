@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+)
+
+// releaseFixture writes a signed index with one release of data to a
+// release directory, and makes the running bundle trust its key.
+func (suite *SushiboxTestSuite) releaseFixture(semver string, data []byte) (dir string, priv ed25519.PrivateKey) {
+	return suite.releaseFixtureOf(release{Version: "2", Semver: semver}, data)
+}
+
+// releaseFixtureOf is releaseFixture for a release with the given version,
+// semver and build time.
+func (suite *SushiboxTestSuite) releaseFixtureOf(r release, data []byte) (dir string, priv ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	suite.Nil(err)
+	signingKey = base64.StdEncoding.EncodeToString(pub)
+
+	dir = filepath.Join(suite.tempDir, "releases")
+	suite.Nil(os.MkdirAll(dir, os.FileMode(0755)))
+	r.File = "data-" + r.Version
+	suite.Nil(ioutil.WriteFile(filepath.Join(dir, r.File), data, os.FileMode(0755)))
+	sum := sha256.Sum256(data)
+	r.Size, r.SHA256 = int64(len(data)), hex.EncodeToString(sum[:])
+	idx := releaseIndex{Name: "data", Releases: []release{r}}
+	buf, err := json.Marshal(idx)
+	suite.Nil(err)
+	suite.Nil(ioutil.WriteFile(filepath.Join(dir, releaseIndexFile), buf, os.FileMode(0644)))
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, buf))
+	suite.Nil(ioutil.WriteFile(filepath.Join(dir, releaseSigFile), []byte(sig+"\n"), os.FileMode(0644)))
+	return
+}
+
+func (suite *SushiboxTestSuite) fakeExecutable() string {
+	exe := filepath.Join(suite.tempDir, "sushibox")
+	suite.Nil(ioutil.WriteFile(exe, []byte("old"), os.FileMode(0755)))
+	executablePath = func() (string, error) { return exe, nil }
+	return exe
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdate() {
+	buildInfoJSON = `{"name":"data","version":"1.0.0","build":"1"}`
+	dir, _ := suite.releaseFixture("1.1.0", []byte("new"))
+	exe := suite.fakeExecutable()
+
+	var buf bytes.Buffer
+	suite.Nil(selfUpdateFrom(&buf, dir))
+	suite.Contains(buf.String(), "from 1 to 2")
+	data, _ := ioutil.ReadFile(exe)
+	suite.Equal("new", string(data))
+	data, _ = ioutil.ReadFile(exe + ".rollback")
+	suite.Equal("old", string(data))
+	info, _ := os.Stat(exe)
+	suite.Equal(os.FileMode(0755), info.Mode().Perm())
+
+	buildInfoJSON = `{"name":"data","version":"1.1.0","build":"2"}`
+	buf.Reset()
+	suite.Nil(selfUpdateFrom(&buf, dir))
+	suite.Equal("2 is up to date\n", buf.String())
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdateHTTP() {
+	buildInfoJSON = `{"name":"data","version":"1.0.0","build":"1"}`
+	dir, _ := suite.releaseFixture("1.1.0", []byte("new"))
+	exe := suite.fakeExecutable()
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer server.Close()
+
+	suite.Nil(selfUpdateFrom(ioutil.Discard, server.URL+"/"))
+	data, _ := ioutil.ReadFile(exe)
+	suite.Equal("new", string(data))
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdateTampered() {
+	buildInfoJSON = `{"name":"data","version":"1.0.0","build":"1"}`
+	dir, _ := suite.releaseFixture("1.1.0", []byte("new"))
+	exe := suite.fakeExecutable()
+	suite.Nil(ioutil.WriteFile(filepath.Join(dir, "data-2"), []byte("bad"), os.FileMode(0755)))
+
+	suite.NotNil(selfUpdateFrom(ioutil.Discard, dir))
+	data, _ := ioutil.ReadFile(exe)
+	suite.Equal("old", string(data))
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdateBadSignature() {
+	buildInfoJSON = `{"name":"data","version":"1.0.0","build":"1"}`
+	dir, _ := suite.releaseFixture("1.1.0", []byte("new"))
+	suite.fakeExecutable()
+	other, _, _ := ed25519.GenerateKey(rand.Reader)
+	signingKey = base64.StdEncoding.EncodeToString(other)
+
+	suite.NotNil(selfUpdateFrom(ioutil.Discard, dir))
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdateUnsigned() {
+	dir, _ := suite.releaseFixture("1.1.0", []byte("new"))
+	signingKey = ""
+	suite.NotNil(selfUpdateFrom(ioutil.Discard, dir))
+}
+
+func (suite *SushiboxTestSuite) TestCompareSemver() {
+	suite.Equal(0, compareSemver("1.2.3", "v1.2.3+build"))
+	suite.Equal(1, compareSemver("1.10.0", "1.9.9"))
+	suite.Equal(-1, compareSemver("1.0.0-rc.1", "1.0.0"))
+	suite.Equal(1, compareSemver("1.0.0-rc.2", "1.0.0-rc.1"))
+}
+
+func (suite *SushiboxTestSuite) TestSelfUpdateReproducible() {
+	buildInfoJSON = `{"name":"data","build":"0123456789abcdef","build_time":"2026-10-01T00:00:00Z"}`
+	dir, _ := suite.releaseFixtureOf(release{Version: "fedcba9876543210", BuildTime: "2026-10-19T00:00:00Z"}, []byte("new"))
+	exe := suite.fakeExecutable()
+	suite.Nil(selfUpdateFrom(ioutil.Discard, dir))
+	data, _ := ioutil.ReadFile(exe)
+	suite.Equal("new", string(data))
+
+	// Builds from the same SOURCE_DATE_EPOCH have nothing to order by.
+	buildInfoJSON = `{"name":"data","build":"0123456789abcdef","build_time":"1970-01-01T00:00:00Z"}`
+	dir, _ = suite.releaseFixtureOf(release{Version: "fedcba9876543210", BuildTime: "1970-01-01T00:00:00Z"}, []byte("newer"))
+	exe = suite.fakeExecutable()
+	err := selfUpdateFrom(ioutil.Discard, dir)
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "-semver")
+	data, _ = ioutil.ReadFile(exe)
+	suite.Equal("old", string(data))
+}
+
+func (suite *SushiboxTestSuite) TestCompareRelease() {
+	c, err := compareRelease(release{Version: "20261019-120000"}, release{Version: "20261001-120000"})
+	suite.Nil(err)
+	suite.Equal(1, c)
+	c, err = compareRelease(release{Version: "20261019-120000", Semver: "1.0.0"}, release{Version: "20261001-120000", Semver: "1.1.0"})
+	suite.Nil(err)
+	suite.Equal(-1, c)
+	c, err = compareRelease(release{Version: "0123456789abcdef", BuildTime: "2026-10-01T00:00:00Z"}, release{Version: "fedcba9876543210", BuildTime: "2026-10-19T00:00:00Z"})
+	suite.Nil(err)
+	suite.Equal(-1, c)
+	c, err = compareRelease(release{Version: "0123456789abcdef", Semver: "1.1.0"}, release{Version: "fedcba9876543210", Semver: "1.0.0"})
+	suite.Nil(err)
+	suite.Equal(1, c)
+	_, err = compareRelease(release{Version: "0123456789abcdef"}, release{Version: "fedcba9876543210"})
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestLoadReleaseIndexTorn() {
+	dir, priv := suite.releaseFixture("1.1.0", []byte("new"))
+	sigPath := filepath.Join(dir, releaseSigFile)
+	sig, err := ioutil.ReadFile(sigPath)
+	suite.Nil(err)
+	stale := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte("{}")))
+
+	// The first fetch gets the signature of another index, like a reader
+	// racing with publish.
+	fetched := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+releaseSigFile {
+			fetched++
+			if fetched == 1 {
+				w.Write([]byte(stale + "\n"))
+				return
+			}
+			w.Write(sig)
+			return
+		}
+		http.FileServer(http.Dir(dir)).ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	idx, err := loadReleaseIndex(server.URL + "/")
+	suite.Nil(err)
+	suite.Equal("data", idx.Name)
+	suite.Equal(2, fetched)
+
+	suite.Nil(ioutil.WriteFile(sigPath, []byte(stale+"\n"), os.FileMode(0644)))
+	_, err = loadReleaseIndex(dir)
+	suite.NotNil(err)
+}
@@ -0,0 +1,29 @@
+package main
+
+// interpreterArgv returns what to exec for the script at cmdPath. Scripts
+// built with -bundled-interpreters run under the interpreter recorded in
+// the manifest, which is checked the same way as a command.
+func interpreterArgv(cmdPath string, args []string) (arg0 string, argv []string, err error) {
+	name, _, err := bundleName(cmdPath)
+	if err != nil {
+		return
+	}
+	e := manifestFile(name)
+	if e == nil || e.Interpreter == "" {
+		return cmdPath, append([]string{cmdPath}, args...), nil
+	}
+
+	arg0, err = assetPath(BaseDir, e.Interpreter)
+	if err != nil {
+		return
+	}
+	if err = checkExecPath(arg0); err != nil {
+		return
+	}
+	if err = verifyExecFile(arg0); err != nil {
+		return
+	}
+	argv = append([]string{arg0}, e.InterpreterArgs...)
+	argv = append(argv, cmdPath)
+	return arg0, append(argv, args...), nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) TestExecCmdBundledInterpreter() {
+	bar := suite.fixtureEntry("bin/bar")
+	bar.Interpreter = "bin/foo"
+	bar.InterpreterArgs = []string{"-e"}
+	suite.setManifest(bar, suite.fixtureEntry("bin/foo"))
+	suite.Nil(restoreFiles())
+
+	var argv0 []string
+	execFunc = func(arg0 string, argv, envv []string) (stdout, stderr []byte, err error) {
+		argv0 = append([]string{arg0}, argv...)
+		return
+	}
+	_, _, err := execCmd("bar", []string{"x"})
+	suite.Nil(err)
+	foo := filepath.Join(BinDir, "foo")
+	suite.Equal([]string{foo, foo, "-e", filepath.Join(BinDir, "bar"), "x"}, argv0)
+
+	execFunc = execMockFunc
+	stdout, _, err := execCmd("bar", []string{})
+	suite.Nil(err)
+	suite.Equal("foo\n", string(stdout))
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdBundledInterpreterTraversal() {
+	bar := suite.fixtureEntry("bin/bar")
+	bar.Interpreter = "../foo"
+	suite.setManifest(bar, suite.fixtureEntry("bin/foo"))
+	suite.Nil(restoreFiles())
+
+	_, _, err := execCmd("bar", []string{})
+	suite.NotNil(err)
+}
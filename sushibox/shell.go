@@ -0,0 +1,125 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// -shell starts an interactive shell in which the bundled commands come
+// first in PATH, like an activated virtualenv. The shell replaces
+// sushibox, so leaving it returns to the caller's unmodified environment.
+const (
+	shellEnv   = "SUSHIBOX_SHELL"
+	promptEnv  = "SUSHIBOX_PROMPT"
+	versionEnv = "SUSHIBOX_VERSION"
+	zdotdirEnv = "SUSHIBOX_ZDOTDIR"
+)
+
+// Startup files which read the user's own ones and then put the prompt
+// marker in front of the prompt, as shells set it from their rc files.
+// This is the only place the marker is added; other shells get it in
+// SUSHIBOX_PROMPT only.
+const bashRC = `[ -f ~/.bashrc ] && . ~/.bashrc
+PS1="$SUSHIBOX_PROMPT$PS1"
+`
+
+// zshEnv reads the user's .zshenv with their ZDOTDIR. When that moves
+// ZDOTDIR, zsh would read the .zshrc there instead of the one below, so
+// the new ZDOTDIR is kept for the .zshrc below to read from.
+const zshEnv = `_sushibox_zdotdir="$ZDOTDIR"
+if [ -n "$SUSHIBOX_ZDOTDIR" ]; then ZDOTDIR="$SUSHIBOX_ZDOTDIR"; else unset ZDOTDIR; fi
+[ -f "${ZDOTDIR:-$HOME}/.zshenv" ] && . "${ZDOTDIR:-$HOME}/.zshenv"
+SUSHIBOX_ZDOTDIR="$ZDOTDIR"
+ZDOTDIR="$_sushibox_zdotdir"
+unset _sushibox_zdotdir
+`
+
+const zshRC = `if [ -n "$SUSHIBOX_ZDOTDIR" ]; then ZDOTDIR="$SUSHIBOX_ZDOTDIR"; else unset ZDOTDIR; fi
+[ -f "${ZDOTDIR:-$HOME}/.zshrc" ] && . "${ZDOTDIR:-$HOME}/.zshrc"
+PROMPT="$SUSHIBOX_PROMPT$PROMPT"
+`
+
+// shellPath returns SUSHIBOX_SHELL, else the user's SHELL.
+func shellPath() string {
+	for _, key := range []string{shellEnv, "SHELL"} {
+		if sh := os.Getenv(key); sh != "" {
+			return sh
+		}
+	}
+	return "/bin/sh"
+}
+
+// shellPrompt is the marker put in front of the prompt.
+func shellPrompt() string {
+	name := "sushibox"
+	if info, err := loadBuildInfo(); err == nil && info.Name != "" {
+		name = info.Name
+	}
+	return "(" + name + ") "
+}
+
+// shellEnviron returns envv as set up for the bundle: the variables
+// commands get, plus the bundled commands in PATH.
+func shellEnviron(envv []string) []string {
+	envv = manEnv(libraryEnv(envv))
+	envv = prependPathEnv(envv, "PATH", BinDir)
+	envv = setEnv(envv, rootEnv, BaseDir)
+	envv = setEnv(envv, versionEnv, Version)
+	return setEnv(envv, promptEnv, shellPrompt())
+}
+
+// writeShellFile writes a startup file under SushiBoxDir/shell.
+func writeShellFile(name, content string) (string, error) {
+	dir := filepath.Join(SushiBoxDir, "shell")
+	if err := os.MkdirAll(dir, os.FileMode(0700)); err != nil {
+		return "", err
+	}
+	p := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(p, []byte(content), os.FileMode(0600)); err != nil {
+		return "", err
+	}
+	return p, nil
+}
+
+// shellArgv returns how to start sh so that it shows the prompt marker.
+func shellArgv(sh string, envv []string) ([]string, []string, error) {
+	switch filepath.Base(sh) {
+	case "bash":
+		rc, err := writeShellFile("bashrc", bashRC)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []string{sh, "--rcfile", rc, "-i"}, envv, nil
+	case "zsh":
+		if _, err := writeShellFile(".zshenv", zshEnv); err != nil {
+			return nil, nil, err
+		}
+		if _, err := writeShellFile(".zshrc", zshRC); err != nil {
+			return nil, nil, err
+		}
+		envv = setEnv(envv, zdotdirEnv, os.Getenv("ZDOTDIR"))
+		envv = setEnv(envv, "ZDOTDIR", filepath.Join(SushiBoxDir, "shell"))
+		return []string{sh, "-i"}, envv, nil
+	}
+	return []string{sh, "-i"}, envv, nil
+}
+
+// runShell starts the shell in place of sushibox.
+func runShell() (stdout, stderr []byte, err error) {
+	sh := shellPath()
+	if !strings.Contains(sh, "/") {
+		// Look the shell up before PATH is changed.
+		if sh, err = exec.LookPath(sh); err != nil {
+			return
+		}
+	}
+	argv, envv, err := shellArgv(sh, shellEnviron(os.Environ()))
+	if err != nil {
+		return
+	}
+	logger.Debug("shell", "path", sh, "argv", argv, "base", BaseDir)
+	return execFunc(sh, argv, envv)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+func (suite *SushiboxTestSuite) TestShellEnviron() {
+	buildInfoJSON = `{"name": "data", "build": "developing"}`
+	envv := shellEnviron([]string{"PATH=/bin", "HOME=/home/alice"})
+	suite.Contains(envv, "PATH="+BinDir+":/bin")
+	suite.Contains(envv, "HOME=/home/alice")
+	suite.Contains(envv, rootEnv+"="+BaseDir)
+	suite.Contains(envv, versionEnv+"="+Version)
+	suite.Contains(envv, promptEnv+"=(data) ")
+
+	// The marker is added by the startup files, not to PS1 itself.
+	envv = shellEnviron([]string{"PS1=$ "})
+	suite.Contains(envv, "PS1=$ ")
+}
+
+func (suite *SushiboxTestSuite) TestShellPromptBash() {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		suite.T().Skip(err)
+	}
+	argv, _, err := shellArgv(bash, nil)
+	suite.Nil(err)
+	cmd := exec.Command(bash, append(argv[1:], "-c", `printf %s "$PS1"`)...)
+	cmd.Env = []string{"HOME=" + suite.tempDir, "PS1=$ ", promptEnv + "=(data) "}
+	out, err := cmd.Output()
+	suite.Nil(err)
+	// The system bashrc may set its own PS1, the marker comes once anyway.
+	suite.True(strings.HasPrefix(string(out), "(data) "))
+	suite.Equal(1, strings.Count(string(out), "(data)"))
+}
+
+func (suite *SushiboxTestSuite) TestZshEnvZdotdir() {
+	_, _, err := shellArgv("/usr/bin/zsh", nil)
+	suite.Nil(err)
+	zdotdir := filepath.Join(suite.tempDir, "zsh")
+	suite.Nil(ioutil.WriteFile(filepath.Join(suite.tempDir, ".zshenv"), []byte("ZDOTDIR="+zdotdir+"\n"), os.FileMode(0644)))
+
+	// A .zshenv which moves ZDOTDIR does not make zsh skip the .zshrc.
+	ours := filepath.Join(SushiBoxDir, "shell")
+	cmd := exec.Command("/bin/sh", "-c", `. "$ZDOTDIR/.zshenv"; echo "$ZDOTDIR $SUSHIBOX_ZDOTDIR"`)
+	cmd.Env = []string{"HOME=" + suite.tempDir, "ZDOTDIR=" + ours, zdotdirEnv + "="}
+	out, err := cmd.Output()
+	suite.Nil(err)
+	suite.Equal(ours+" "+zdotdir+"\n", string(out))
+}
+
+func (suite *SushiboxTestSuite) TestShellArgv() {
+	argv, _, err := shellArgv("/bin/bash", nil)
+	suite.Nil(err)
+	rc := filepath.Join(SushiBoxDir, "shell", "bashrc")
+	suite.Equal([]string{"/bin/bash", "--rcfile", rc, "-i"}, argv)
+	buf, _ := ioutil.ReadFile(rc)
+	suite.Equal(bashRC, string(buf))
+
+	argv, envv, err := shellArgv("/usr/bin/zsh", []string{"HOME=/home/alice"})
+	suite.Nil(err)
+	suite.Equal([]string{"/usr/bin/zsh", "-i"}, argv)
+	suite.Contains(envv, "ZDOTDIR="+filepath.Join(SushiBoxDir, "shell"))
+	_, err = os.Stat(filepath.Join(SushiBoxDir, "shell", ".zshrc"))
+	suite.Nil(err)
+
+	argv, _, err = shellArgv("/bin/sh", nil)
+	suite.Nil(err)
+	suite.Equal([]string{"/bin/sh", "-i"}, argv)
+}
+
+func (suite *SushiboxTestSuite) TestRunShell() {
+	sh := filepath.Join(suite.tempDir, "testsh")
+	suite.Nil(ioutil.WriteFile(sh, []byte("#!/bin/sh\necho \"$1 $SUSHIBOX_ROOT\"\ncommand -v foo\n"), os.FileMode(0755)))
+	os.Setenv(shellEnv, sh)
+	suite.Nil(restoreFiles())
+
+	stdout, _, err := runShell()
+	suite.Nil(err)
+	lines := strings.Split(strings.TrimSpace(string(stdout)), "\n")
+	suite.Equal([]string{"-i " + BaseDir, filepath.Join(BinDir, "foo")}, lines)
+}
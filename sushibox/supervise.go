@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	modeExec      = "exec"
+	modeSupervise = "supervise"
+)
+
+// forwardedSignals are passed on to a supervised command.
+var forwardedSignals = []os.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGWINCH}
+
+// childExit is returned when a supervised command did not exit with 0.
+type childExit struct {
+	status syscall.WaitStatus
+}
+
+func (e *childExit) Error() string {
+	if e.status.Signaled() {
+		return fmt.Sprintf("command killed by %s", e.status.Signal())
+	}
+	return fmt.Sprintf("command exited with %d", e.status.ExitStatus())
+}
+
+// code is the exit status of the command as a shell reports it.
+func (e *childExit) code() int {
+	if e.status.Signaled() {
+		return 128 + int(e.status.Signal())
+	}
+	return e.status.ExitStatus()
+}
+
+// propagate ends sushibox the way the command ended: by the same signal,
+// or with the same exit status.
+func (e *childExit) propagate() int {
+	if e.status.Signaled() {
+		sig := e.status.Signal()
+		signal.Reset(sig)
+		syscall.Kill(os.Getpid(), sig)
+	}
+	return e.code()
+}
+
+// superviseFunc runs the command as a child instead of replacing
+// sushibox. The child gets a process group of its own, which is put in
+// the foreground of the terminal, so that signals from the terminal reach
+// the child alone and ones sent to sushibox are forwarded to it once.
+var superviseFunc = func(arg0 string, argv, envv []string) (stdout, stderr []byte, err error) {
+	cmd := &exec.Cmd{Path: arg0, Args: argv, Env: envv, Stdin: os.Stdin, Stdout: os.Stdout, Stderr: os.Stderr}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	tty := foregroundTerminal()
+	if tty >= 0 {
+		cmd.SysProcAttr.Foreground = true
+		cmd.SysProcAttr.Ctty = tty
+	}
+
+	sigs := make(chan os.Signal, 8)
+	signal.Notify(sigs, forwardedSignals...)
+	defer signal.Stop(sigs)
+	if err = cmd.Start(); err != nil {
+		return
+	}
+	pid := cmd.Process.Pid
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case sig := <-sigs:
+				syscall.Kill(-pid, sig.(syscall.Signal))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	status, err := waitChild(pid, tty)
+	cmd.Process.Release()
+	if err == nil && (status.Signaled() || status.ExitStatus() != 0) {
+		err = &childExit{status}
+	}
+	return
+}
+
+// waitChild waits for the command to exit and takes the terminal back.
+// When the command is stopped from the terminal, sushibox stops too so
+// that the shell regains the terminal, and continues the command when it
+// is continued itself.
+func waitChild(pid, tty int) (syscall.WaitStatus, error) {
+	options := 0
+	if tty >= 0 {
+		options = syscall.WUNTRACED
+	}
+	for {
+		var status syscall.WaitStatus
+		_, err := syscall.Wait4(pid, &status, options, nil)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err != nil {
+			return status, err
+		}
+		if tty >= 0 {
+			setForeground(tty, syscall.Getpgrp())
+		}
+		if !status.Stopped() {
+			return status, nil
+		}
+		syscall.Kill(os.Getpid(), syscall.SIGSTOP)
+		setForeground(tty, pid)
+		syscall.Kill(-pid, syscall.SIGCONT)
+	}
+}
+
+// foregroundTerminal returns the descriptor of the terminal on stdin when
+// sushibox is in its foreground, or -1.
+func foregroundTerminal() int {
+	fd := int(os.Stdin.Fd())
+	var pgrp int32
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), syscall.TIOCGPGRP, uintptr(unsafe.Pointer(&pgrp))); errno != 0 {
+		return -1
+	}
+	if int(pgrp) != syscall.Getpgrp() {
+		return -1
+	}
+	return fd
+}
+
+// setForeground puts pgrp in the foreground of the terminal. sushibox may
+// be in the background when it does so, which would stop it by SIGTTOU.
+func setForeground(tty, pgrp int) {
+	signal.Ignore(syscall.SIGTTOU)
+	defer signal.Reset(syscall.SIGTTOU)
+	p := int32(pgrp)
+	syscall.Syscall(syscall.SYS_IOCTL, uintptr(tty), syscall.TIOCSPGRP, uintptr(unsafe.Pointer(&p)))
+}
+
+// runFunc returns how a command with the given settings is run. Post-exec
+// hooks and the audit log need sushibox to wait for the command.
+func runFunc(c commandConfig) func(arg0 string, argv, envv []string) ([]byte, []byte, error) {
+	if c.Mode == modeSupervise || hookExists(hookPostExec) || auditEnabled() {
+		return superviseFunc
+	}
+	return execFunc
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+var realSuperviseFunc = superviseFunc
+
+func (suite *SushiboxTestSuite) script(name, content string) string {
+	p := filepath.Join(suite.tempDir, name)
+	suite.Nil(ioutil.WriteFile(p, []byte(content), os.FileMode(0700)))
+	return p
+}
+
+func (suite *SushiboxTestSuite) TestSuperviseExitStatus() {
+	p := suite.script("exit3", "#!/bin/sh\nexit 3\n")
+	_, _, err := superviseFunc(p, []string{p}, os.Environ())
+	exit, ok := err.(*childExit)
+	suite.True(ok)
+	suite.Equal(3, exit.propagate())
+
+	p = suite.script("ok", "#!/bin/sh\nexit 0\n")
+	_, _, err = superviseFunc(p, []string{p}, os.Environ())
+	suite.Nil(err)
+}
+
+func (suite *SushiboxTestSuite) TestSuperviseKilled() {
+	p := suite.script("killed", "#!/bin/sh\nkill -KILL $$\n")
+	_, _, err := superviseFunc(p, []string{p}, os.Environ())
+	exit, ok := err.(*childExit)
+	suite.True(ok)
+	suite.True(exit.status.Signaled())
+	suite.Equal(syscall.SIGKILL, exit.status.Signal())
+	suite.Equal("command killed by killed", exit.Error())
+}
+
+func (suite *SushiboxTestSuite) TestSuperviseForwardsSignals() {
+	ready := filepath.Join(suite.tempDir, "ready")
+	out := filepath.Join(suite.tempDir, "out")
+	p := suite.script("trap", "#!/bin/sh\ntrap 'echo term > "+out+"; exit 7' TERM\ntouch "+ready+"\nwhile :; do sleep 0.05; done\n")
+
+	result := make(chan error)
+	go func() {
+		_, _, err := superviseFunc(p, []string{p}, os.Environ())
+		result <- err
+	}()
+	for i := 0; i < 100; i++ {
+		if _, err := os.Stat(ready); err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	suite.Nil(syscall.Kill(os.Getpid(), syscall.SIGTERM))
+
+	err := <-result
+	exit, ok := err.(*childExit)
+	suite.True(ok)
+	suite.Equal(7, exit.propagate())
+	buf, _ := ioutil.ReadFile(out)
+	suite.Equal("term\n", string(buf))
+}
+
+func (suite *SushiboxTestSuite) TestSuperviseGroupSignalOnce() {
+	// Signal a process group of the test alone, like a terminal signals
+	// its foreground group.
+	pgrp := syscall.Getpgrp()
+	suite.Nil(syscall.Setpgid(0, 0))
+	defer syscall.Setpgid(0, pgrp)
+
+	ready := filepath.Join(suite.tempDir, "ready")
+	out := filepath.Join(suite.tempDir, "out")
+	p := suite.script("trap", "#!/bin/sh\ntrap 'echo int >> "+out+"' INT\ntrap 'exit 7' TERM\ntouch "+ready+"\nwhile :; do sleep 0.05; done\n")
+
+	result := make(chan error)
+	go func() {
+		_, _, err := superviseFunc(p, []string{p}, os.Environ())
+		result <- err
+	}()
+	for i := 0; i < 100; i++ {
+		if _, err := os.Stat(ready); err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	suite.Nil(syscall.Kill(0, syscall.SIGINT))
+	time.Sleep(300 * time.Millisecond)
+	suite.Nil(syscall.Kill(os.Getpid(), syscall.SIGTERM))
+
+	err := <-result
+	exit, ok := err.(*childExit)
+	suite.True(ok)
+	suite.Equal(7, exit.propagate())
+	buf, _ := ioutil.ReadFile(out)
+	suite.Equal("int\n", string(buf))
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdSupervised() {
+	commandsJSON = `{"foo":{"mode":"supervise"}}`
+	var called string
+	superviseFunc = func(arg0 string, argv, envv []string) (stdout, stderr []byte, err error) {
+		called = arg0
+		return
+	}
+	suite.Nil(restoreFiles())
+	_, _, err := execCmd("foo", []string{})
+	suite.Nil(err)
+	suite.Equal(filepath.Join(BinDir, "foo"), called)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 )
 
 var HomeDir = homeDir()
@@ -21,25 +22,116 @@ func main() {
 }
 
 func realMain() int {
+	closeDebug, err := initDebug()
+	if err != nil {
+		// A bad debug setting must not keep the command from running.
+		fmt.Fprintf(os.Stderr, "Warning: initDebug failed by %+v, debug logging is off\n", err)
+	}
+	defer closeDebug()
+
 	if err := initDirs(); err != nil {
 		return errorExit("initDirs failed by %+v", err)
 	}
+	if err := loadManifest(); err != nil {
+		return errorExit("loadManifest failed by %+v", err)
+	}
 
 	cmd, args, err := parseArgs()
-	if *version {
+	if *version && err == nil {
 		return 0
 	}
 	if err != nil {
 		return errorExit("parseArgs failed by %+v", err)
 	}
+	if *audit {
+		if err := printAudit(os.Stdout, cmd, *jsonOutput); err != nil {
+			return errorExit("printAudit failed by %+v", err)
+		}
+		return 0
+	}
+	if *versions {
+		if err := printVersions(os.Stdout, *jsonOutput); err != nil {
+			return errorExit("printVersions failed by %+v", err)
+		}
+		return 0
+	}
+	if *completion != "" {
+		if err := writeCompletion(os.Stdout, *completion); err != nil {
+			return errorExit("writeCompletion failed by %+v", err)
+		}
+		return 0
+	}
+	if *selfUpdate != "" {
+		if err := selfUpdateFrom(os.Stdout, *selfUpdate); err != nil {
+			return errorExit("selfUpdate failed by %+v", err)
+		}
+		return 0
+	}
+	if *verify {
+		if err := verifyBundle(); err != nil {
+			return errorExit("verifyBundle failed by %+v", err)
+		}
+		fmt.Printf("OK\n")
+		return 0
+	}
 
-	if err := checkFilesInfo(); err != nil {
+	if err := verifySignature(); err != nil {
+		return errorExit("verifySignature failed by %+v", err)
+	}
+
+	pinned := false
+	if pin := pinnedVersion(); pin != "" {
+		if pinned, err = usePinnedVersion(pin); err != nil {
+			return errorExit("usePinnedVersion failed by %+v", err)
+		}
+	}
+	if pinned {
+		// The payload of an earlier build is not in this binary, so a
+		// pinned extraction can be checked but not restored.
+		if err := checkEntriesInfo(); err != nil {
+			return errorExit("checkEntriesInfo failed by %+v", err)
+		}
+	} else if err := checkFilesInfo(); err != nil {
+		logger.Debug("restoring files", "reason", err)
 		if err = restoreFiles(); err != nil {
 			return errorExit("restoreFiles failed by %+v", err)
 		}
 	}
+	touchVersion()
+
+	if *man != "" {
+		if err := showManPage(os.Stdout, *man); err != nil {
+			return errorExit("showManPage failed by %+v", err)
+		}
+		return 0
+	}
+	if *shell {
+		if _, _, err := runShell(); err != nil {
+			return errorExit("runShell failed by %+v", err)
+		}
+		return 0
+	}
+	if isHelp(cmd) {
+		if len(args) == 0 {
+			usage()
+			return 0
+		}
+		if err := printHelp(os.Stdout, args[0]); err != nil {
+			return errorExit("printHelp failed by %+v", err)
+		}
+		return 0
+	}
+	if *complete {
+		if err := completeCmd(os.Stdout, cmd, args); err != nil {
+			return errorExit("completeCmd failed by %+v", err)
+		}
+		return 0
+	}
 
 	_, _, err = execCmd(cmd, args)
+	if exit, ok := err.(*childExit); ok {
+		return exit.propagate()
+	}
 	if err != nil {
 		return errorExit("execCmd failed by %+v", err)
 	}
@@ -58,45 +150,77 @@ func initDirs() error {
 	SushiBoxDir = filepath.Join(HomeDir, ".sushibox")
 	VersionsDir = filepath.Join(SushiBoxDir, "versions")
 
-	err := os.MkdirAll(SushiBoxDir, os.FileMode(0755))
+	err := os.MkdirAll(SushiBoxDir, os.FileMode(0700))
 	if err != nil {
 		return err
 	}
-	err = os.MkdirAll(VersionsDir, os.FileMode(0755))
+	err = os.MkdirAll(VersionsDir, os.FileMode(0700))
+	if err != nil {
+		return err
+	}
+	err = checkSecureTree(SushiBoxDir, VersionsDir)
 	if err != nil {
 		return err
 	}
 
-	BaseDir = filepath.Join(VersionsDir, Version)
+	BaseDir = filepath.Join(VersionsDir, versionDirName())
 	BinDir = filepath.Join(BaseDir, "bin")
+	logger.Debug("initDirs", "home", HomeDir, "base", BaseDir, "version", Version, "payload", PayloadHash)
 	return nil
 }
 
 var version = flag.Bool("version", false, "show version")
+var verify = flag.Bool("verify", false, "verify the bundle signature and asset hashes")
+var jsonOutput = flag.Bool("json", false, "print -version and -audit as JSON")
+var audit = flag.Bool("audit", false, "print the audit log, of all commands or of the given one")
+var selfUpdate = flag.String("self-update", "", "update this binary from a release directory or URL")
+var versions = flag.Bool("versions", false, "list the extracted versions")
+var use = flag.String("use", "", "run the command from an earlier extracted version (also "+pinEnv+")")
+var completion = flag.String("completion", "", "print a completion script for bash, zsh or fish")
+var man = flag.String("man", "", "show the bundled man page of a command")
+var shell = flag.Bool("shell", false, "start $SHELL, or "+shellEnv+", with the bundled commands in PATH")
+var complete = flag.Bool("complete", false, "print completions for a command, used by completion scripts")
 
 func parseArgs() (cmd string, args []string, err error) {
 	cmd, args = filepath.Base(os.Args[0]), os.Args[1:]
-	*version = false
+	*version, *verify, *jsonOutput, *audit, *selfUpdate = false, false, false, false, ""
+	*versions, *use = false, ""
+	*completion, *complete = "", false
+	*man, *shell = "", false
 	if cmd == "sushibox" {
-		flag.Usage = func() {
-			fmt.Printf("Usage: %s [options] command args...\n\n", cmd)
-			flag.PrintDefaults()
-		}
+		flag.Usage = usage
 
 		flag.Parse()
 
 		if *version {
-			fmt.Printf("%s\n", Version)
+			err = printVersion(os.Stdout, *jsonOutput)
+			return
+		}
+		if *verify {
+			return
+		}
+		if *audit {
+			cmd, args = flag.Arg(0), nil
+			return
+		}
+		if *selfUpdate != "" || *versions || *completion != "" || *man != "" || *shell {
+			return
+		}
+		if *complete {
+			if flag.NArg() > 0 {
+				cmd, args = flag.Arg(0), flag.Args()[1:]
+			}
 			return
 		}
 
-		if len(args) == 0 {
+		if flag.NArg() == 0 {
 			flag.Usage()
 			err = fmt.Errorf("missing args")
 		} else {
 			cmd, args = flag.Args()[0], flag.Args()[1:]
 		}
 	}
+	logger.Debug("parseArgs", "argv0", os.Args[0], "cmd", cmd, "args", args)
 	return
 }
 
@@ -107,27 +231,34 @@ func checkFilesInfo() error {
 			return err
 		}
 
-		path := filepath.Join(BaseDir, name)
+		path, err := assetPath(BaseDir, name)
+		if err != nil {
+			return err
+		}
 		fileInfo, err := os.Stat(path)
 		if err != nil {
+			logger.Debug("checkFilesInfo missing", "path", path, "err", err)
 			return err
 		}
 
-		if assetinfo.Size() != fileInfo.Size() {
-			return fmt.Errorf("check info error %s: size is different", path)
+		if plainSize(name, assetinfo) != fileInfo.Size() {
+			return mismatch(path, "size", plainSize(name, assetinfo), fileInfo.Size())
 		}
-		if assetinfo.Mode() != fileInfo.Mode() {
-			return fmt.Errorf("check info error %s: mode is different", path)
+		if restoreMode(assetinfo.Mode()) != fileInfo.Mode() {
+			return mismatch(path, "mode", restoreMode(assetinfo.Mode()), fileInfo.Mode())
 		}
 		if assetinfo.ModTime() != fileInfo.ModTime() {
-			return fmt.Errorf("check info error %s: mtime is different", path)
+			return mismatch(path, "mtime", assetinfo.ModTime(), fileInfo.ModTime())
 		}
+		trace("checkFilesInfo ok", "path", path)
 	}
-	return nil
+	return checkEntriesInfo()
 }
 
 func restoreFiles() error {
-	tempDir, err := ioutil.TempDir("", fmt.Sprintf("sushibox_%s_", Version))
+	// Extract next to BaseDir so that the rename is atomic and restored
+	// files can be hardlinked into the object store.
+	tempDir, err := ioutil.TempDir(VersionsDir, fmt.Sprintf(".%s_", versionDirName()))
 	if err != nil {
 		return err
 	}
@@ -135,29 +266,68 @@ func restoreFiles() error {
 		os.RemoveAll(tempDir)
 	}()
 
-	err = RestoreAssets(tempDir, "")
+	start := time.Now()
+	err = restoreAssets(tempDir)
 	if err != nil {
 		return err
 	}
+	logger.Debug("restoreFiles", "dir", tempDir, "assets", len(AssetNames()), "duration", time.Since(start))
 
 	err = os.Rename(tempDir, BaseDir)
 	if os.IsNotExist(err) {
 		return err
 	}
 
-	return checkFilesInfo()
+	err = checkFilesInfo()
+	if err != nil {
+		return err
+	}
+	logger.Debug("restoreFiles done", "base", BaseDir, "duration", time.Since(start))
+	return writeVersionInfo()
 }
 
 var execFunc = func(arg0 string, argv, envv []string) (stdout, stderr []byte, err error) {
-	syscall.Exec(arg0, argv, envv)
-	return // never called
+	// Exec returns only when it fails.
+	err = syscall.Exec(arg0, argv, envv)
+	return
 }
 
 func execCmd(cmd string, args []string) (stdout, stderr []byte, err error) {
+	cwd, _ := os.Getwd()
 	cmdPath := filepath.Join(BinDir, cmd)
-	argv := append([]string{cmdPath}, args...)
-	envv := os.Environ()
-	return execFunc(cmdPath, argv, envv)
+	if err = checkExecPath(cmdPath); err != nil {
+		return
+	}
+	if err = verifyExecFile(cmdPath); err != nil {
+		return
+	}
+	arg0, argv, err := interpreterArgv(cmdPath, args)
+	if err != nil {
+		return
+	}
+	settings, err := commandSettings(cmd)
+	if err != nil {
+		return
+	}
+	envv := commandEnv(settings, manEnv(libraryEnv(os.Environ())))
+	if err = applyCommandDir(settings); err != nil {
+		return
+	}
+	if err = runHook(hookPreExec, cmd, args, envv); err != nil {
+		return
+	}
+	logger.Debug("exec", "path", arg0, "argv", argv, "dir", commandDir(settings), "mode", settings.Mode)
+	start := time.Now()
+	stdout, stderr, err = runFunc(settings)(arg0, argv, envv)
+	if auditEnabled() {
+		if auditErr := writeAudit(cmd, args, cwd, start, err); auditErr != nil {
+			fmt.Fprintf(os.Stderr, "Warning: writeAudit failed by %+v\n", auditErr)
+		}
+	}
+	if hookExists(hookPostExec) {
+		runPostHook(cmd, args, envv, err)
+	}
+	return
 }
 
 func errorExit(format string, a ...interface{}) int {
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"github.com/stretchr/testify/suite"
 	"io/ioutil"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"testing"
@@ -19,7 +23,29 @@ func TestSuite(t *testing.T) {
 
 func (suite *SushiboxTestSuite) SetupTest() {
 	mockDir = "test"
+	manifestJSON = ""
+	manifest = nil
+	manifestSignature, signingKey = "", ""
+	os.Unsetenv(verifyKeyEnv)
+	encryptionSalt, assetCipher = "", nil
+	os.Unsetenv(keyEnv)
+	os.Unsetenv(keyFileEnv)
+	mockNames = nil
+	PayloadHash = ""
+	os.Unsetenv(dedupeEnv)
+	libDir = ""
+	buildInfoJSON = ""
+	commandsJSON = ""
+	auditJSON = ""
+	os.Unsetenv(auditEnv)
+	os.Unsetenv(pinEnv)
+	os.Unsetenv(shellEnv)
+	executablePath = os.Executable
+	indexRetryDelay = 0
+	logger = slog.New(slog.DiscardHandler)
 	execFunc = execMockFunc
+	superviseFunc = realSuperviseFunc
+	manFunc = realManFunc
 	suite.tempDir, _ = ioutil.TempDir("", "sushibox_test_")
 	HomeDir = suite.tempDir
 	err := initDirs()
@@ -42,12 +68,32 @@ func (suite *SushiboxTestSuite) TestInitVersionsDir() {
 	suite.True(info.IsDir())
 }
 
+func (suite *SushiboxTestSuite) TestInitDirsPermissions() {
+	for _, dir := range []string{SushiBoxDir, VersionsDir} {
+		info, _ := os.Stat(dir)
+		suite.Equal(os.ModeDir|0700, info.Mode())
+	}
+}
+
+func (suite *SushiboxTestSuite) TestInitDirsInsecure() {
+	suite.Nil(os.Chmod(VersionsDir, os.FileMode(0777)))
+	err := initDirs()
+	suite.NotNil(err)
+	suite.Contains(err.Error(), VersionsDir)
+}
+
 func (suite *SushiboxTestSuite) TestInitBaseDir() {
 	suite.Equal(filepath.Join(VersionsDir, "developing"), BaseDir)
 	_, err := os.Stat(BaseDir)
 	suite.True(os.IsNotExist(err))
 }
 
+func (suite *SushiboxTestSuite) TestInitBaseDirPayloadHash() {
+	PayloadHash = "0123abcd"
+	suite.Nil(initDirs())
+	suite.Equal(filepath.Join(VersionsDir, "0123abcd"), BaseDir)
+}
+
 func (suite *SushiboxTestSuite) TestInitBinDir() {
 	suite.Equal(filepath.Join(BaseDir, "bin"), BinDir)
 	_, err := os.Stat(BinDir)
@@ -96,7 +142,7 @@ func (suite *SushiboxTestSuite) TestParseArgsVersionAsOtherCommand() {
 }
 
 func (suite *SushiboxTestSuite) TestCheckFilesInfo() {
-	suite.Nil(RestoreAssets(BaseDir, ""))
+	suite.Nil(restoreAssets(BaseDir))
 	suite.Nil(checkFilesInfo())
 }
 
@@ -105,7 +151,7 @@ func (suite *SushiboxTestSuite) TestCheckFilesInfoNoFile() {
 }
 
 func (suite *SushiboxTestSuite) TestCheckFilesInfoModified() {
-	suite.Nil(RestoreAssets(BaseDir, ""))
+	suite.Nil(restoreAssets(BaseDir))
 	os.Truncate(filepath.Join(BaseDir, AssetNames()[0]), 1)
 	suite.NotNil(checkFilesInfo())
 }
@@ -118,6 +164,141 @@ func (suite *SushiboxTestSuite) TestRestoreFiles() {
 	suite.True(os.SameFile(fi1, fi2))
 }
 
+func (suite *SushiboxTestSuite) setManifest(entries ...manifestEntry) {
+	buf, err := json.Marshal(entries)
+	suite.Nil(err)
+	manifestJSON = string(buf)
+	suite.Nil(loadManifest())
+}
+
+// mockFixtures replaces the payload under test/ with one in tempDir which
+// has only bin/foo. Tests add their own files with mockFile.
+func (suite *SushiboxTestSuite) mockFixtures() {
+	mockDir = filepath.Join(suite.tempDir, "mock")
+	suite.mockFile("bin/foo", "#!/bin/sh\n", os.FileMode(0755))
+}
+
+// mockFile writes a file of the mock payload, creating its directories.
+func (suite *SushiboxTestSuite) mockFile(name, content string, mode os.FileMode) {
+	p := filepath.Join(mockDir, filepath.FromSlash(name))
+	suite.Nil(os.MkdirAll(filepath.Dir(p), os.FileMode(0755)))
+	suite.Nil(ioutil.WriteFile(p, []byte(content), mode))
+}
+
+func (suite *SushiboxTestSuite) fixtureEntry(name string) manifestEntry {
+	info, err := os.Lstat(filepath.Join(mockDir, name))
+	suite.Nil(err)
+	data, err := ioutil.ReadFile(filepath.Join(mockDir, name))
+	suite.Nil(err)
+	sum := sha256.Sum256(data)
+	return manifestEntry{Name: name, Type: entryFile, Mode: info.Mode(), Hash: hex.EncodeToString(sum[:])}
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesManifest() {
+	suite.setManifest(
+		manifestEntry{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0755},
+		suite.fixtureEntry("bin/bar"),
+		manifestEntry{Name: "bin/baz", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "foo"},
+		suite.fixtureEntry("bin/foo"),
+		manifestEntry{Name: "bin/qux", Type: entryHardlink, Mode: 0755, Target: "bin/foo"},
+		manifestEntry{Name: "var", Type: entryDir, Mode: os.ModeDir | 0755},
+		manifestEntry{Name: "var/log", Type: entryDir, Mode: os.ModeDir | 0750},
+	)
+	suite.Nil(restoreFiles())
+
+	target, err := os.Readlink(filepath.Join(BinDir, "baz"))
+	suite.Nil(err)
+	suite.Equal("foo", target)
+
+	fi1, _ := os.Stat(filepath.Join(BinDir, "foo"))
+	fi2, _ := os.Stat(filepath.Join(BinDir, "qux"))
+	suite.True(os.SameFile(fi1, fi2))
+
+	info, err := os.Stat(filepath.Join(BaseDir, "var", "log"))
+	suite.Nil(err)
+	suite.Equal(os.ModeDir|0750, info.Mode())
+
+	stdout, _, err := execCmd("baz", []string{})
+	suite.Equal("foo\n", string(stdout))
+	suite.Nil(err)
+}
+
+func (suite *SushiboxTestSuite) TestCheckEntriesInfoHardlink() {
+	suite.setManifest(
+		manifestEntry{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0755},
+		suite.fixtureEntry("bin/foo"),
+		manifestEntry{Name: "bin/qux", Type: entryHardlink, Mode: 0755, Target: "bin/foo"},
+	)
+	suite.Nil(restoreFiles())
+	suite.Nil(checkEntriesInfo())
+
+	qux := filepath.Join(BinDir, "qux")
+	suite.Nil(os.Remove(qux))
+	suite.Nil(ioutil.WriteFile(qux, []byte("#!/bin/sh\necho foo\n"), os.FileMode(0755)))
+	err := checkEntriesInfo()
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "hardlink is different")
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEscapingSymlink() {
+	suite.setManifest(
+		manifestEntry{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0755},
+		manifestEntry{Name: "bin/evil", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "../../etc/passwd"},
+	)
+	suite.NotNil(restoreFiles())
+	_, err := os.Stat(BaseDir)
+	suite.True(os.IsNotExist(err))
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesEscapingSymlinkChain() {
+	suite.setManifest(
+		manifestEntry{Name: "d", Type: entryDir, Mode: os.ModeDir | 0755},
+		manifestEntry{Name: "d/link", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: ".."},
+		manifestEntry{Name: "e", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "d/link/.."},
+	)
+	suite.NotNil(restoreFiles())
+	_, err := os.Stat(BaseDir)
+	suite.True(os.IsNotExist(err))
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesRejectsTraversal() {
+	for _, name := range []string{"../../evil", "bin/../../../evil", "/tmp/evil", "..\\..\\evil", "./bin/foo", ""} {
+		mockNames = []string{"bin/foo", name}
+		suite.NotNil(restoreFiles(), name)
+		_, err := os.Stat(BaseDir)
+		suite.True(os.IsNotExist(err), name)
+		_, err = os.Stat(filepath.Join(HomeDir, "evil"))
+		suite.True(os.IsNotExist(err), name)
+	}
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesRejectsManifestTraversal() {
+	suite.setManifest(manifestEntry{Name: "../outside", Type: entryDir, Mode: os.ModeDir | 0755})
+	suite.NotNil(restoreFiles())
+	_, err := os.Stat(filepath.Join(VersionsDir, "outside"))
+	suite.True(os.IsNotExist(err))
+
+	suite.setManifest(manifestEntry{Name: "bin/evil", Type: entryHardlink, Mode: 0644, Target: "../../../../etc/passwd"})
+	suite.NotNil(restoreFiles())
+}
+
+func (suite *SushiboxTestSuite) TestCheckFilesInfoRejectsTraversal() {
+	suite.Nil(restoreFiles())
+	mockNames = []string{"bin/foo", "../developing/bin/foo"}
+	suite.NotNil(checkFilesInfo())
+}
+
+func (suite *SushiboxTestSuite) TestCheckFilesInfoSymlinkModified() {
+	suite.setManifest(
+		manifestEntry{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0755},
+		manifestEntry{Name: "bin/baz", Type: entrySymlink, Mode: os.ModeSymlink | 0777, Target: "foo"},
+	)
+	suite.Nil(restoreFiles())
+	suite.Nil(os.Remove(filepath.Join(BinDir, "baz")))
+	suite.Nil(os.Symlink("bar", filepath.Join(BinDir, "baz")))
+	suite.NotNil(checkFilesInfo())
+}
+
 func (suite *SushiboxTestSuite) TestExecCmd() {
 	suite.Nil(restoreFiles())
 	stdout, stderr, err := execCmd("foo", []string{})
@@ -126,6 +307,48 @@ func (suite *SushiboxTestSuite) TestExecCmd() {
 	suite.Nil(err)
 }
 
+func (suite *SushiboxTestSuite) TestRestoreFilesStripsWritableBits() {
+	suite.setManifest(
+		manifestEntry{Name: "bin", Type: entryDir, Mode: os.ModeDir | 0777},
+		suite.fixtureEntry("bin/foo"),
+	)
+	suite.Nil(restoreFiles())
+	info, _ := os.Stat(BinDir)
+	suite.Equal(os.ModeDir|0755, info.Mode())
+	info, _ = os.Stat(filepath.Join(BinDir, "foo"))
+	suite.Equal(os.FileMode(0), info.Mode()&insecureBits)
+	suite.Nil(checkFilesInfo())
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdInsecureDir() {
+	suite.Nil(restoreFiles())
+	suite.Nil(os.Chmod(BinDir, os.FileMode(0777)))
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+	suite.Contains(err.Error(), BinDir)
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdInsecureFile() {
+	suite.Nil(restoreFiles())
+	path := filepath.Join(BinDir, "foo")
+	suite.Nil(os.Chmod(path, os.FileMode(0777)))
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+	suite.Contains(err.Error(), path)
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdInsecureOwner() {
+	if os.Getuid() != 0 {
+		suite.T().Skip("changing ownership requires root")
+	}
+	suite.Nil(restoreFiles())
+	path := filepath.Join(BinDir, "foo")
+	suite.Nil(os.Lchown(path, 12345, -1))
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+	suite.Contains(err.Error(), path)
+}
+
 func (suite *SushiboxTestSuite) TestExecCmdNotExecutable() {
 	suite.Nil(restoreFiles())
 	stdout, stderr, err := execCmd("bar", []string{})
@@ -139,6 +362,18 @@ func (suite *SushiboxTestSuite) TestRealMain() {
 	suite.Equal(0, realMain())
 }
 
+func (suite *SushiboxTestSuite) TestRealMainBadDebug() {
+	os.Setenv(debugEnv, "verbose")
+	defer os.Unsetenv(debugEnv)
+	os.Args = []string{"sushibox", "foo"}
+	suite.Equal(0, realMain())
+
+	os.Setenv(debugEnv, "1")
+	os.Setenv(debugFileEnv, filepath.Join(suite.tempDir, "missing", "debug.log"))
+	defer os.Unsetenv(debugFileEnv)
+	suite.Equal(0, realMain())
+}
+
 func (suite *SushiboxTestSuite) TestRealMainAsOtherCommand() {
 	os.Args = []string{"foo"}
 	suite.Equal(0, realMain())
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// manifestSignature and signingKey are replaced by sushimaster when the
+// bundle is built with -sign-key. Both are base64 encoded.
+var manifestSignature = ""
+var signingKey = ""
+
+const verifyKeyEnv = "SUSHIBOX_VERIFY_KEY"
+
+// verifyKey returns the public key configured by SUSHIBOX_VERIFY_KEY, or
+// the one embedded at build time.
+func verifyKey() (ed25519.PublicKey, error) {
+	if path := os.Getenv(verifyKeyEnv); path != "" {
+		return readPublicKey(path)
+	}
+	if signingKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("embedded public key has wrong size %d", len(key))
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+func readPublicKey(path string) (ed25519.PublicKey, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key %s: %v", path, err)
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key %s is not ed25519", path)
+	}
+	return pub, nil
+}
+
+// verifySignature checks the manifest signature. Unsigned bundles pass
+// unless a verify key is configured.
+func verifySignature() error {
+	key, err := verifyKey()
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return nil
+	}
+	if manifestSignature == "" {
+		return fmt.Errorf("bundle is not signed")
+	}
+	sig, err := base64.StdEncoding.DecodeString(manifestSignature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(key, []byte(manifestJSON), sig) {
+		return fmt.Errorf("manifest signature does not match")
+	}
+	return nil
+}
+
+// manifestFile returns the entry of a file, following hardlinks to the
+// file they were recorded against.
+func manifestFile(name string) *manifestEntry {
+	for _, e := range manifest {
+		if e.Name == name && e.Type == entryHardlink {
+			name = e.Target
+			break
+		}
+	}
+	for i, e := range manifest {
+		if e.Name == name && e.Type == entryFile {
+			return &manifest[i]
+		}
+	}
+	return nil
+}
+
+// manifestHash returns the recorded hash of a file.
+func manifestHash(name string) string {
+	if e := manifestFile(name); e != nil {
+		return e.Hash
+	}
+	return ""
+}
+
+func sha256Hex(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyAssetData checks asset contents against the manifest. Without a
+// signature every asset is accepted, with one every asset must be listed.
+func verifyAssetData(name string, data []byte) error {
+	expected := manifestHash(name)
+	if expected == "" {
+		if manifestSignature != "" {
+			return fmt.Errorf("asset %s is not in the signed manifest", name)
+		}
+		return nil
+	}
+	actual := sha256.Sum256(data)
+	if hex.EncodeToString(actual[:]) != expected {
+		return fmt.Errorf("asset %s: hash does not match manifest", name)
+	}
+	return nil
+}
+
+// verifyFile checks an extracted file against the manifest hash of name.
+func verifyFile(name, path string) error {
+	expected := manifestHash(name)
+	if expected == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	actual, err := sha256Hex(f)
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return fmt.Errorf("file %s: hash does not match manifest", path)
+	}
+	return nil
+}
+
+// bundleName returns the manifest name of the file p resolves to.
+func bundleName(p string) (name, resolved string, err error) {
+	resolved, err = filepath.EvalSymlinks(p)
+	if err != nil {
+		return
+	}
+	base, err := filepath.EvalSymlinks(BaseDir)
+	if err != nil {
+		return
+	}
+	rel, err := filepath.Rel(base, resolved)
+	if err != nil {
+		return
+	}
+	return filepath.ToSlash(rel), resolved, nil
+}
+
+// verifyExecFile checks the file a command resolves to before exec.
+func verifyExecFile(cmdPath string) error {
+	name, resolved, err := bundleName(cmdPath)
+	if err != nil {
+		return err
+	}
+	return verifyFile(name, resolved)
+}
+
+// verifyBundle checks the signature and every embedded asset.
+func verifyBundle() error {
+	if err := verifySignature(); err != nil {
+		return err
+	}
+	for _, name := range AssetNames() {
+		data, err := Asset(name)
+		if err != nil {
+			return err
+		}
+		data, err = decryptAsset(name, data)
+		if err != nil {
+			return err
+		}
+		if err := verifyAssetData(name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
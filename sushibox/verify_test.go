@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func (suite *SushiboxTestSuite) signBundle(entries ...manifestEntry) ed25519.PrivateKey {
+	suite.setManifest(entries...)
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	suite.Nil(err)
+	manifestSignature = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(manifestJSON)))
+	signingKey = base64.StdEncoding.EncodeToString(pub)
+	return priv
+}
+
+func (suite *SushiboxTestSuite) writePublicKey(pub ed25519.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	suite.Nil(err)
+	path := filepath.Join(suite.tempDir, "verify.pem")
+	suite.Nil(ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), os.FileMode(0644)))
+	return path
+}
+
+func (suite *SushiboxTestSuite) TestVerifyBundle() {
+	suite.signBundle(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+	suite.Nil(verifyBundle())
+	suite.Nil(restoreFiles())
+	_, _, err := execCmd("foo", []string{})
+	suite.Nil(err)
+}
+
+func (suite *SushiboxTestSuite) TestVerifyUnsigned() {
+	suite.Nil(verifyBundle())
+}
+
+func (suite *SushiboxTestSuite) TestVerifyTamperedManifest() {
+	suite.signBundle(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+	manifestJSON = manifestJSON[:len(manifestJSON)-1] + " ]"
+	suite.NotNil(verifySignature())
+	suite.NotNil(verifyBundle())
+}
+
+func (suite *SushiboxTestSuite) TestVerifyTamperedAsset() {
+	foo := suite.fixtureEntry("bin/foo")
+	foo.Hash = "0000"
+	suite.signBundle(suite.fixtureEntry("bin/bar"), foo)
+	suite.Nil(verifySignature())
+	suite.NotNil(verifyBundle())
+	suite.NotNil(restoreFiles())
+}
+
+func (suite *SushiboxTestSuite) TestVerifyUnlistedAsset() {
+	suite.signBundle(suite.fixtureEntry("bin/foo"))
+	suite.NotNil(verifyBundle())
+}
+
+func (suite *SushiboxTestSuite) TestVerifyConfiguredKey() {
+	priv := suite.signBundle(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+	os.Setenv(verifyKeyEnv, suite.writePublicKey(priv.Public().(ed25519.PublicKey)))
+	suite.Nil(verifySignature())
+
+	other, _, _ := ed25519.GenerateKey(rand.Reader)
+	os.Setenv(verifyKeyEnv, suite.writePublicKey(other))
+	suite.NotNil(verifySignature())
+}
+
+func (suite *SushiboxTestSuite) TestVerifyConfiguredKeyUnsigned() {
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	os.Setenv(verifyKeyEnv, suite.writePublicKey(pub))
+	suite.NotNil(verifySignature())
+}
+
+func (suite *SushiboxTestSuite) TestExecCmdModifiedFile() {
+	suite.signBundle(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+	suite.Nil(restoreFiles())
+	path := filepath.Join(BinDir, "foo")
+	data, _ := ioutil.ReadFile(path)
+	suite.Nil(ioutil.WriteFile(path, []byte(strings.Replace(string(data), "foo", "bar", 1)), os.FileMode(0755)))
+	_, _, err := execCmd("foo", []string{})
+	suite.NotNil(err)
+}
+
+func (suite *SushiboxTestSuite) TestRealMainVerify() {
+	suite.signBundle(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+	os.Args = []string{"sushibox", "-verify"}
+	suite.Equal(0, realMain())
+
+	manifestSignature = base64.StdEncoding.EncodeToString(make([]byte, ed25519.SignatureSize))
+	os.Args = []string{"sushibox", "-verify"}
+	suite.Equal(1, realMain())
+	os.Args = []string{"sushibox", "foo"}
+	suite.Equal(1, realMain())
+}
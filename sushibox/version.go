@@ -1,3 +1,7 @@
 package main
 
 const Version = "developing"
+
+// PayloadHash is replaced by sushimaster with the hash of the manifest. It
+// names the directory the payload is extracted to.
+var PayloadHash = ""
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const dedupeEnv = "SUSHIBOX_DEDUPE"
+
+type versionInfo struct {
+	Version string `json:"version"`
+	Payload string `json:"payload,omitempty"`
+
+	// Manifest lets a pinned extraction be verified after the binary it
+	// came from has been replaced. Signature and Key are those of the
+	// manifest, as the file itself is not signed.
+	Manifest  string `json:"manifest,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Key       string `json:"key,omitempty"`
+}
+
+// versionDirName is the payload hash, so that identical content shares an
+// extraction, or the version for bundles built without a manifest.
+func versionDirName() string {
+	if PayloadHash != "" {
+		return PayloadHash
+	}
+	return Version
+}
+
+// writeVersionInfo keeps the human readable version next to the
+// content-addressed directory.
+func writeVersionInfo() error {
+	buf, err := json.Marshal(versionInfo{Version: Version, Payload: PayloadHash, Manifest: manifestJSON, Signature: manifestSignature, Key: signingKey})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(BaseDir+".json", buf, os.FileMode(0600))
+}
+
+func dedupeEnabled() bool {
+	return os.Getenv(dedupeEnv) != ""
+}
+
+// dedupeFiles replaces restored files under dir with hardlinks into the
+// object store shared by all versions, or adds them to it. Objects are
+// keyed by hash, mode and mtime since hardlinks share all three.
+func dedupeFiles(dir string) error {
+	objectsDir := filepath.Join(SushiBoxDir, "objects")
+	err := os.MkdirAll(objectsDir, os.FileMode(0700))
+	if err != nil {
+		return err
+	}
+	err = checkSecurePath(objectsDir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range manifest {
+		if e.Type != entryFile || e.Hash == "" {
+			continue
+		}
+		p, err := assetPath(dir, e.Name)
+		if err != nil {
+			return err
+		}
+		info, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+		obj := filepath.Join(objectsDir, fmt.Sprintf("%s-%o-%d", e.Hash, info.Mode().Perm(), info.ModTime().Unix()))
+
+		objInfo, err := os.Lstat(obj)
+		if os.IsNotExist(err) {
+			if err := os.Link(p, obj); err != nil && !os.IsExist(err) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !objInfo.Mode().IsRegular() || checkSecurePath(obj) != nil || verifyFile(e.Name, obj) != nil {
+			continue
+		}
+		tmp := p + ".dedupe"
+		if err := os.Link(obj, tmp); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
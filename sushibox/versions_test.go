@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func (suite *SushiboxTestSuite) TestRestoreFilesWritesVersionInfo() {
+	PayloadHash = "0123abcd"
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+
+	buf, err := ioutil.ReadFile(filepath.Join(VersionsDir, "0123abcd.json"))
+	suite.Nil(err)
+	var info versionInfo
+	suite.Nil(json.Unmarshal(buf, &info))
+	suite.Equal(versionInfo{Version: Version, Payload: "0123abcd"}, info)
+
+	list, _ := ioutil.ReadDir(VersionsDir)
+	suite.Equal(2, len(list))
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesDedupe() {
+	os.Setenv(dedupeEnv, "1")
+	suite.setManifest(
+		suite.fixtureEntry("bin/bar"),
+		suite.fixtureEntry("bin/foo"),
+		manifestEntry{Name: "bin/qux", Type: entryHardlink, Mode: 0755, Target: "bin/foo"},
+	)
+
+	PayloadHash = "v1"
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+	PayloadHash = "v2"
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+	suite.Nil(checkFilesInfo())
+
+	fi1, _ := os.Stat(filepath.Join(VersionsDir, "v1", "bin", "foo"))
+	fi2, _ := os.Stat(filepath.Join(VersionsDir, "v2", "bin", "foo"))
+	fi3, _ := os.Stat(filepath.Join(VersionsDir, "v2", "bin", "qux"))
+	suite.True(os.SameFile(fi1, fi2))
+	suite.True(os.SameFile(fi2, fi3))
+
+	list, _ := ioutil.ReadDir(filepath.Join(SushiBoxDir, "objects"))
+	suite.Equal(2, len(list))
+}
+
+func (suite *SushiboxTestSuite) TestRestoreFilesDedupeTamperedObject() {
+	os.Setenv(dedupeEnv, "1")
+	suite.setManifest(suite.fixtureEntry("bin/bar"), suite.fixtureEntry("bin/foo"))
+
+	PayloadHash = "v1"
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+	list, _ := ioutil.ReadDir(filepath.Join(SushiBoxDir, "objects"))
+	for _, fi := range list {
+		path := filepath.Join(SushiBoxDir, "objects", fi.Name())
+		suite.Nil(os.Remove(path))
+		suite.Nil(ioutil.WriteFile(path, []byte("tampered"), fi.Mode()))
+	}
+
+	PayloadHash = "v2"
+	suite.Nil(initDirs())
+	suite.Nil(restoreFiles())
+	stdout, _, err := execCmd("foo", []string{})
+	suite.Nil(err)
+	suite.Equal("foo\n", string(stdout))
+}
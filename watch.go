@@ -0,0 +1,159 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var watch = flag.Bool("watch", false, "rebuild whenever the input directory changes")
+
+// watchDebounce is how long the input has to stay unchanged before a
+// rebuild starts, so that saving several files rebuilds once.
+var watchDebounce = 300 * time.Millisecond
+
+// watchPollInterval is how often the input is scanned when inotify is not
+// available.
+var watchPollInterval = time.Second
+
+// watchMain builds once and then again after every change to input, until
+// interrupted. The work directory is kept between builds, so a rebuild
+// only translates the files which changed.
+func watchMain(workDir, input, output string) int {
+	ignore := func(p string) bool {
+		return p == output || p == workDir || strings.HasPrefix(p, workDir+string(filepath.Separator))
+	}
+	root, err := filepath.Abs(input)
+	if err != nil {
+		return errorExit("%+v", err)
+	}
+	changes, err := newWatcher(root, ignore)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "inotify is not available, polling every %s: %v\n", watchPollInterval, err)
+		changes = pollWatcher(root, ignore, watchPollInterval)
+	}
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	rebuild := func() {
+		start := time.Now()
+		result, err := build(workDir, input, output)
+		elapsed := time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s build failed in %s: %+v\n", start.Format("15:04:05"), elapsed, err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "%s built %s %s (%d entries) in %s\n", start.Format("15:04:05"), output, result.version, result.entries, elapsed)
+	}
+
+	rebuild()
+	fmt.Fprintf(os.Stderr, "watching %s\n", input)
+	debounced := debounce(changes, watchDebounce)
+	for {
+		select {
+		case <-debounced:
+			rebuild()
+		case <-sig:
+			return 0
+		}
+	}
+}
+
+// debounce signals once in has been quiet for d after signalling.
+func debounce(in <-chan struct{}, d time.Duration) <-chan struct{} {
+	out := make(chan struct{})
+	go func() {
+		for range in {
+			timer := time.NewTimer(d)
+		quiet:
+			for {
+				select {
+				case _, ok := <-in:
+					if !ok {
+						break quiet
+					}
+					timer.Reset(d)
+				case <-timer.C:
+					break quiet
+				}
+			}
+			timer.Stop()
+			out <- struct{}{}
+		}
+		close(out)
+	}()
+	return out
+}
+
+type fileState struct {
+	size  int64
+	mode  os.FileMode
+	mtime time.Time
+}
+
+func snapshot(input string, ignore func(string) bool) map[string]fileState {
+	files := make(map[string]fileState)
+	filepath.Walk(input, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if ignore(p) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			// Added and removed entries show up by themselves.
+			files[p] = fileState{mode: info.Mode()}
+			return nil
+		}
+		files[p] = fileState{info.Size(), info.Mode(), info.ModTime()}
+		return nil
+	})
+	return files
+}
+
+// pollWatcher scans input every interval and signals when anything was
+// added, removed or modified.
+func pollWatcher(input string, ignore func(string) bool, interval time.Duration) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	go func() {
+		prev := snapshot(input, ignore)
+		for range time.Tick(interval) {
+			cur := snapshot(input, ignore)
+			if !sameSnapshot(prev, cur) {
+				notify(changes)
+			}
+			prev = cur
+		}
+	}()
+	return changes
+}
+
+func sameSnapshot(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for p, s := range a {
+		if t, ok := b[p]; !ok || s != t {
+			return false
+		}
+	}
+	return true
+}
+
+// notify signals on a buffered channel without blocking, since one
+// pending change is as good as many.
+func notify(changes chan struct{}) {
+	select {
+	case changes <- struct{}{}:
+	default:
+	}
+}
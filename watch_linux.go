@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MODIFY | syscall.IN_ATTRIB |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF
+
+// newWatcher watches every directory of input with inotify, adding new
+// directories as they appear.
+func newWatcher(input string, ignore func(string) bool) (<-chan struct{}, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	dirs := make(map[int]string)
+	addDirs := func() error {
+		return filepath.Walk(input, func(p string, info os.FileInfo, err error) error {
+			if err != nil || !info.IsDir() {
+				return nil
+			}
+			if ignore(p) {
+				return filepath.SkipDir
+			}
+			wd, err := syscall.InotifyAddWatch(fd, p, inotifyMask)
+			if err != nil {
+				return err
+			}
+			dirs[wd] = p
+			return nil
+		})
+	}
+	if err := addDirs(); err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer syscall.Close(fd)
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+		for {
+			n, err := syscall.Read(fd, buf)
+			if err == syscall.EINTR {
+				continue
+			}
+			if err != nil || n <= 0 {
+				return
+			}
+			changed, newDir, overflow := readEvents(buf[:n], dirs, ignore)
+			if overflow {
+				// Events were dropped, directories created meanwhile
+				// among them, so every directory is watched again and
+				// the rebuild scans the whole input anyway.
+				for wd := range dirs {
+					delete(dirs, wd)
+				}
+			}
+			if newDir || overflow {
+				addDirs()
+			}
+			if changed || overflow {
+				notify(changes)
+			}
+		}
+	}()
+	return changes, nil
+}
+
+// readEvents reads the inotify events in buf and tells whether any of them
+// was not ignored, whether a directory was added and whether the queue
+// overflowed.
+func readEvents(buf []byte, dirs map[int]string, ignore func(string) bool) (changed, newDir, overflow bool) {
+	for offset := 0; offset+syscall.SizeofInotifyEvent <= len(buf); {
+		event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+		name := buf[offset+syscall.SizeofInotifyEvent : offset+syscall.SizeofInotifyEvent+int(event.Len)]
+		offset += syscall.SizeofInotifyEvent + int(event.Len)
+
+		if event.Mask&syscall.IN_Q_OVERFLOW != 0 {
+			overflow = true
+			continue
+		}
+		p := dirs[int(event.Wd)]
+		if i := bytes.IndexByte(name, 0); i >= 0 {
+			name = name[:i]
+		}
+		if len(name) > 0 {
+			p = filepath.Join(p, string(name))
+		}
+		if ignore(p) {
+			continue
+		}
+		changed = true
+		if event.Mask&syscall.IN_ISDIR != 0 && event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+			newDir = true
+		}
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"syscall"
+	"testing"
+)
+
+func inotifyEvent(wd int32, mask uint32, name string) []byte {
+	n := len(name)
+	if n > 0 {
+		n = (n/16 + 1) * 16
+	}
+	buf := make([]byte, syscall.SizeofInotifyEvent+n)
+	binary.LittleEndian.PutUint32(buf[0:], uint32(wd))
+	binary.LittleEndian.PutUint32(buf[4:], mask)
+	binary.LittleEndian.PutUint32(buf[12:], uint32(n))
+	copy(buf[syscall.SizeofInotifyEvent:], name)
+	return buf
+}
+
+func TestReadEvents(t *testing.T) {
+	dirs := map[int]string{1: "/input", 2: "/input/bin"}
+	ignore := func(p string) bool { return p == "/input/sushibox" }
+
+	changed, newDir, overflow := readEvents(inotifyEvent(1, syscall.IN_MODIFY, "sushibox"), dirs, ignore)
+	assert.False(t, changed || newDir || overflow)
+
+	buf := append(inotifyEvent(1, syscall.IN_MODIFY, "sushibox"), inotifyEvent(2, syscall.IN_CREATE, "foo")...)
+	changed, newDir, overflow = readEvents(buf, dirs, ignore)
+	assert.True(t, changed)
+	assert.False(t, newDir || overflow)
+
+	changed, newDir, _ = readEvents(inotifyEvent(1, syscall.IN_CREATE|syscall.IN_ISDIR, "lib"), dirs, ignore)
+	assert.True(t, changed && newDir)
+
+	_, _, overflow = readEvents(inotifyEvent(-1, syscall.IN_Q_OVERFLOW, ""), dirs, ignore)
+	assert.True(t, overflow)
+}
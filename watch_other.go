@@ -0,0 +1,11 @@
+//go:build !linux
+
+package main
+
+import (
+	"fmt"
+)
+
+func newWatcher(input string, ignore func(string) bool) (<-chan struct{}, error) {
+	return nil, fmt.Errorf("inotify is only available on Linux")
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func waitChange(changes <-chan struct{}, timeout time.Duration) bool {
+	select {
+	case <-changes:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	in := make(chan struct{})
+	out := debounce(in, 50*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		in <- struct{}{}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, waitChange(out, time.Second))
+	assert.False(t, waitChange(out, 100*time.Millisecond))
+	close(in)
+}
+
+func testWatcher(t *testing.T, start func(input string, ignore func(string) bool) <-chan struct{}) {
+	input, err := ioutil.TempDir("", "sushimaster_watch_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(input)
+	assert.Nil(t, os.Mkdir(filepath.Join(input, "bin"), os.FileMode(0755)))
+	output := filepath.Join(input, "sushibox")
+
+	changes := start(input, func(p string) bool { return p == output })
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Nil(t, ioutil.WriteFile(output, []byte("ignored"), os.FileMode(0755)))
+	assert.False(t, waitChange(changes, 200*time.Millisecond))
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "bin", "foo"), []byte("foo"), os.FileMode(0755)))
+	assert.True(t, waitChange(changes, time.Second))
+
+	// Directories created after the watch started are watched too.
+	assert.Nil(t, os.Mkdir(filepath.Join(input, "lib"), os.FileMode(0755)))
+	assert.True(t, waitChange(changes, time.Second))
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(input, "lib", "bar"), []byte("bar"), os.FileMode(0644)))
+	assert.True(t, waitChange(changes, time.Second))
+}
+
+func TestPollWatcher(t *testing.T) {
+	testWatcher(t, func(input string, ignore func(string) bool) <-chan struct{} {
+		return pollWatcher(input, ignore, 20*time.Millisecond)
+	})
+}
+
+func TestNewWatcher(t *testing.T) {
+	testWatcher(t, func(input string, ignore func(string) bool) <-chan struct{} {
+		changes, err := newWatcher(input, ignore)
+		if err != nil {
+			t.Skip(err)
+		}
+		return changes
+	})
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+)
+
+var keepWork = flag.Bool("work", false, "print the name of the temporary work directory and do not delete it")
+var workDirPath = flag.String("workdir", "", "use and keep this work directory, translating only files which changed since the last build")
+
+// fragmentDir keeps the translated assets between builds, so that a
+// kept work directory only translates files which changed.
+const fragmentDir = "bindata"
+
+// fragmentFormat changes whenever fragments or the prelude are laid out
+// differently.
+const fragmentFormat = "1"
+
+// fragmentGeneration identifies what fragments were translated by: their
+// layout, sushimaster and go-bindata. Fragments and preludes of another
+// generation, e.g. in a work directory kept across an upgrade, are not
+// reused.
+var fragmentGeneration = func() string {
+	generation := fragmentFormat + " " + currentSushimasterVersion()
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/jteeuwen/go-bindata" {
+				generation += " " + dep.Version + " " + dep.Sum
+			}
+		}
+	}
+	return generation
+}
+
+// preludeFile is the name of the prelude of the current generation.
+func preludeFile() string {
+	sum := sha256.Sum256([]byte(fragmentGeneration()))
+	return "prelude-" + hex.EncodeToString(sum[:8]) + ".go.fragment"
+}
+
+// openWorkDir returns the directory to generate and build in, and a func
+// removing it unless it is to be kept.
+func openWorkDir() (string, func(), error) {
+	if *workDirPath != "" {
+		dir, err := filepath.Abs(*workDirPath)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := os.MkdirAll(dir, os.FileMode(0700)); err != nil {
+			return "", nil, err
+		}
+		return dir, func() {}, nil
+	}
+
+	dir, err := ioutil.TempDir("", "sushimaster_")
+	if err != nil {
+		return "", nil, err
+	}
+	if *keepWork {
+		fmt.Fprintf(os.Stderr, "WORK=%s\n", dir)
+		return dir, func() {}, nil
+	}
+	return dir, func() { os.RemoveAll(dir) }, nil
+}
+
+// fragmentKey identifies what the fragment of an asset is translated
+// from: its name, contents, mode and mtime, how it is encrypted and the
+// generation translating it.
+func fragmentKey(e manifestEntry, salt string) string {
+	h := sha256.New()
+	for _, s := range []string{fragmentGeneration(), e.Name, e.Hash, strconv.FormatUint(uint64(e.Mode.Perm()), 8), strconv.FormatInt(e.ModTime, 10), salt} {
+		fmt.Fprintf(h, "%d:%s\n", len(s), s)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func fragmentPath(workDir, key string) string {
+	return filepath.Join(workDir, fragmentDir, key+".go.fragment")
+}
+
+// regenerateBindata writes bindata.go from the fragments in workDir,
+// staging and translating only the assets which have none yet. Encrypted
+// builds never reuse any, their salt is random.
+func regenerateBindata(workDir, input, salt string, entries []manifestEntry, transform func(name string, data []byte) ([]byte, error)) error {
+	if _, err := os.Stat(filepath.Join(input, "bin")); err != nil {
+		return fmt.Errorf("bin directory does not exist under %s", input)
+	}
+	dir := filepath.Join(workDir, fragmentDir)
+	if err := os.MkdirAll(dir, os.FileMode(0700)); err != nil {
+		return err
+	}
+	preludePath := filepath.Join(dir, preludeFile())
+	prelude, err := ioutil.ReadFile(preludePath)
+	fresh := err != nil
+
+	keys := make(map[string]string)
+	var changed []manifestEntry
+	for _, e := range entries {
+		if e.Type != entryFile {
+			continue
+		}
+		keys[e.Name] = fragmentKey(e, salt)
+		if _, err := os.Stat(fragmentPath(workDir, keys[e.Name])); err != nil || fresh {
+			changed = append(changed, e)
+		}
+	}
+	if *verbose {
+		fmt.Fprintf(os.Stderr, "translating %d of %d assets\n", len(changed), len(keys))
+	}
+
+	output := filepath.Join(workDir, "bindata.go")
+	if len(changed) > 0 {
+		source := filepath.Join(workDir, "payload")
+		// Files staged for an earlier build must not be translated again.
+		if err := os.RemoveAll(source); err != nil {
+			return err
+		}
+		if err := stageInput(source, input, changed, transform); err != nil {
+			return err
+		}
+		if err := translateBindata(output, source); err != nil {
+			return err
+		}
+		src, err := ioutil.ReadFile(output)
+		if err != nil {
+			return err
+		}
+		var fragments map[string][]byte
+		prelude, fragments, err = splitBindata(output, src)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(preludePath, prelude, os.FileMode(0600)); err != nil {
+			return err
+		}
+		for name, fragment := range fragments {
+			if err := ioutil.WriteFile(fragmentPath(workDir, keys[name]), fragment, os.FileMode(0600)); err != nil {
+				return err
+			}
+		}
+	}
+
+	fragments := make(map[string][]byte)
+	used := map[string]bool{preludeFile(): true}
+	for name, key := range keys {
+		buf, err := ioutil.ReadFile(fragmentPath(workDir, key))
+		if err != nil {
+			return err
+		}
+		fragments[name] = buf
+		used[filepath.Base(fragmentPath(workDir, key))] = true
+	}
+	src, err := assembleBindata(prelude, fragments)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(output, src, os.FileMode(0644)); err != nil {
+		return err
+	}
+
+	// Fragments of files since changed or removed are not needed anymore.
+	list, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range list {
+		if !used[fi.Name()] {
+			if err := os.Remove(filepath.Join(dir, fi.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// cleanWorkDir removes Go files which are neither runtime sources of this
+// sushimaster nor bindata.go, e.g. ones an older sushimaster wrote into a
+// kept work directory.
+func cleanWorkDir(workDir string) error {
+	keep := map[string]bool{"bindata.go": true}
+	for _, name := range AssetNames() {
+		keep[name] = true
+	}
+	matches, err := filepath.Glob(filepath.Join(workDir, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, p := range matches {
+		if !keep[filepath.Base(p)] {
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
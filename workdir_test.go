@@ -0,0 +1,123 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRegenerateBindata(t *testing.T) {
+	input := makeInput(t)
+	defer os.RemoveAll(input)
+	writeScript(t, input, "bin/tool", "#!/bin/sh\n")
+	workDir, err := ioutil.TempDir("", "sushimaster_work_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(workDir)
+
+	var translated []string
+	transform := func(name string, data []byte) ([]byte, error) {
+		translated = append(translated, name)
+		return data, nil
+	}
+	bindata := filepath.Join(workDir, "bindata.go")
+	entries, err := collectManifest(input)
+	assert.Nil(t, err)
+	assert.Nil(t, regenerateBindata(workDir, input, "", entries, transform))
+	assert.Equal(t, []string{"bin/python3", "bin/tool"}, translated)
+	first, err := ioutil.ReadFile(bindata)
+	assert.Nil(t, err)
+
+	// Nothing changed, so nothing is translated and the output is the same.
+	translated = nil
+	assert.Nil(t, regenerateBindata(workDir, input, "", entries, transform))
+	assert.Empty(t, translated)
+	buf, err := ioutil.ReadFile(bindata)
+	assert.Nil(t, err)
+	assert.Equal(t, string(first), string(buf))
+
+	// Only the changed file is translated, the other one is reused.
+	writeScript(t, input, "bin/tool", "#!/bin/sh\necho\n")
+	mtime := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(filepath.Join(input, "bin", "tool"), mtime, mtime))
+	entries, err = collectManifest(input)
+	assert.Nil(t, err)
+	translated = nil
+	assert.Nil(t, regenerateBindata(workDir, input, "", entries, transform))
+	assert.Equal(t, []string{"bin/tool"}, translated)
+	buf, err = ioutil.ReadFile(bindata)
+	assert.Nil(t, err)
+	assert.Contains(t, string(buf), `"bin/python3"`)
+	assert.Contains(t, string(buf), `"bin/tool"`)
+	assert.NotEqual(t, string(first), string(buf))
+
+	// The fragments of removed files are dropped.
+	assert.Nil(t, os.Remove(filepath.Join(input, "bin", "tool")))
+	entries, err = collectManifest(input)
+	assert.Nil(t, err)
+	assert.Nil(t, regenerateBindata(workDir, input, "", entries, transform))
+	buf, err = ioutil.ReadFile(bindata)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(buf), `"bin/tool"`)
+	list, err := ioutil.ReadDir(filepath.Join(workDir, fragmentDir))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(list))
+
+	// A different salt translates everything again.
+	translated = nil
+	assert.Nil(t, regenerateBindata(workDir, input, "salt", entries, transform))
+	assert.Equal(t, []string{"bin/python3"}, translated)
+
+	// So does another sushimaster, and the old prelude is dropped.
+	generation := fragmentGeneration
+	defer func() { fragmentGeneration = generation }()
+	fragmentGeneration = func() string { return "upgraded" }
+	translated = nil
+	assert.Nil(t, regenerateBindata(workDir, input, "salt", entries, transform))
+	assert.Equal(t, []string{"bin/python3"}, translated)
+	list, err = ioutil.ReadDir(filepath.Join(workDir, fragmentDir))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(list))
+	_, err = os.Stat(filepath.Join(workDir, fragmentDir, preludeFile()))
+	assert.Nil(t, err)
+}
+
+func TestCleanWorkDir(t *testing.T) {
+	workDir, err := ioutil.TempDir("", "sushimaster_work_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(workDir)
+
+	names := []string{AssetNames()[0], "bindata.go", "removed.go", "notes.txt"}
+	for _, name := range names {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(workDir, name), []byte("package main\n"), os.FileMode(0644)))
+	}
+	assert.Nil(t, cleanWorkDir(workDir))
+	for _, name := range names {
+		_, err := os.Stat(filepath.Join(workDir, name))
+		assert.Equal(t, name == "removed.go", os.IsNotExist(err), name)
+	}
+}
+
+func TestOpenWorkDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sushimaster_work_")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	*workDirPath = filepath.Join(dir, "work")
+	defer func() { *workDirPath = "" }()
+	workDir, cleanup, err := openWorkDir()
+	assert.Nil(t, err)
+	assert.Equal(t, *workDirPath, workDir)
+	cleanup()
+	_, err = os.Stat(workDir)
+	assert.Nil(t, err)
+
+	*workDirPath = ""
+	workDir, cleanup, err = openWorkDir()
+	assert.Nil(t, err)
+	cleanup()
+	_, err = os.Stat(workDir)
+	assert.True(t, os.IsNotExist(err))
+}